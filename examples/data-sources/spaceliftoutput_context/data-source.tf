@@ -0,0 +1,12 @@
+data "spaceliftoutput_context" "shared_network" {
+  context_id = "shared-network"
+}
+
+output "vpc_cidr" {
+  value = data.spaceliftoutput_context.shared_network.environment["VPC_CIDR"]
+}
+
+# Write-only entries are listed without their values
+output "secret_variable_names" {
+  value = [for v in data.spaceliftoutput_context.shared_network.environment_variables : v.name if v.write_only]
+}
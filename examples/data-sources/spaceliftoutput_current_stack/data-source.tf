@@ -0,0 +1,14 @@
+data "spaceliftoutput_current_stack" "this" {}
+
+locals {
+  vpc_id = aws_vpc.main.id
+}
+
+output "vpc_id" {
+  value = local.vpc_id
+}
+
+# Flag when this run changes an output consumed by other stacks
+output "vpc_id_changed" {
+  value = lookup(data.spaceliftoutput_current_stack.this.outputs, "vpc_id", null) != local.vpc_id
+}
@@ -0,0 +1,11 @@
+data "spaceliftoutput_drift_status" "network" {
+  stack_id = "network"
+}
+
+# Refuse to plan against a drifted upstream
+check "network_not_drifted" {
+  assert {
+    condition     = !data.spaceliftoutput_drift_status.network.drifted
+    error_message = "The network stack has ${data.spaceliftoutput_drift_status.network.drifted_resources} drifted resource(s)."
+  }
+}
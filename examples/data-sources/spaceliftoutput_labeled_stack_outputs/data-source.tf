@@ -0,0 +1,9 @@
+data "spaceliftoutput_labeled_stack_outputs" "network" {
+  labels = ["component:network"]
+  space  = "root/prod"
+}
+
+# Map of network stack ID to its VPC ID
+output "vpc_ids" {
+  value = { for stack_id, outputs in data.spaceliftoutput_labeled_stack_outputs.network.outputs : stack_id => outputs["vpc_id"] }
+}
@@ -0,0 +1,10 @@
+data "spaceliftoutput_policies" "compliance" {
+  types  = ["PLAN", "APPROVAL"]
+  labels = ["compliance"]
+  space  = "root/prod"
+}
+
+# Map of policy name to the stacks it is attached to
+output "compliance_policy_stacks" {
+  value = { for policy in data.spaceliftoutput_policies.compliance.policies : policy.name => policy.attached_stack_ids }
+}
@@ -0,0 +1,18 @@
+data "spaceliftoutput_run" "network_release" {
+  stack_id = "network"
+  run_id   = var.network_run_id
+}
+
+# Only proceed if the upstream applied without destroying anything
+resource "terraform_data" "gate" {
+  lifecycle {
+    precondition {
+      condition = (
+        data.spaceliftoutput_run.network_release.tracked &&
+        data.spaceliftoutput_run.network_release.state == "FINISHED" &&
+        data.spaceliftoutput_run.network_release.resources_deleted == 0
+      )
+      error_message = "The network stack run did not apply cleanly without destroys."
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "spaceliftoutput_runs" "network" {
+  stack_id = "network"
+  types    = ["TRACKED"]
+  branch   = "main"
+  limit    = 5
+}
+
+output "last_tracked_run_state" {
+  value = try(data.spaceliftoutput_runs.network.runs[0].state, null)
+}
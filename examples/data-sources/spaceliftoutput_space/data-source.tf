@@ -0,0 +1,11 @@
+data "spaceliftoutput_space" "network" {
+  path = "root/prod/network"
+}
+
+data "spaceliftoutput_space" "by_id" {
+  space_id = "network-01HZX7K8WQ"
+}
+
+output "network_space_id" {
+  value = data.spaceliftoutput_space.network.id
+}
@@ -0,0 +1,6 @@
+data "spaceliftoutput_spaces" "all" {}
+
+# Map of space path to space ID
+output "space_ids" {
+  value = { for space in data.spaceliftoutput_spaces.all.spaces : space.path => space.id }
+}
@@ -0,0 +1,11 @@
+data "spaceliftoutput_stack_dependencies" "app" {
+  stack_id = "app"
+}
+
+# Map of upstream stack ID to the outputs it passes to this stack
+output "upstream_outputs" {
+  value = {
+    for dependency in data.spaceliftoutput_stack_dependencies.app.upstream :
+    dependency.stack_id => [for reference in dependency.references : reference.output_name]
+  }
+}
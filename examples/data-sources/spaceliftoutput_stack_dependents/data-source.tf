@@ -0,0 +1,12 @@
+data "spaceliftoutput_stack_dependents" "vpc_id" {
+  stack_id    = "network"
+  output_name = "vpc_id"
+}
+
+# Warn in plans while other stacks still consume the output
+check "vpc_id_unused" {
+  assert {
+    condition     = length(data.spaceliftoutput_stack_dependents.vpc_id.dependent_stack_ids) == 0
+    error_message = "The vpc_id output is still consumed by: ${join(", ", data.spaceliftoutput_stack_dependents.vpc_id.dependent_stack_ids)}"
+  }
+}
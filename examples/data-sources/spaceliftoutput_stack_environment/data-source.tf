@@ -0,0 +1,15 @@
+data "spaceliftoutput_stack_environment" "network" {
+  stack_id = "network"
+}
+
+output "region" {
+  value = data.spaceliftoutput_stack_environment.network.environment["AWS_REGION"]
+}
+
+# Environment variables inherited from attached contexts
+output "inherited_variables" {
+  value = {
+    for v in data.spaceliftoutput_stack_environment.network.environment_variables : v.name => v.context_id
+    if v.origin == "context"
+  }
+}
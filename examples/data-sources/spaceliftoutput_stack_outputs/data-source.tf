@@ -9,4 +9,28 @@ output "all_stack_outputs" {
 # Example of using a specific output
 output "specific_output" {
   value = data.spaceliftoutput_stack_outputs.example.outputs["output_name"]
-} 
\ No newline at end of file
+} 
+
+# Select the stack by name instead of ID
+data "spaceliftoutput_stack_outputs" "by_name" {
+  stack_name = "vpc"
+  space      = "root/prod/network"
+}
+
+# Read outputs, with their types, from the stack's managed state
+data "spaceliftoutput_stack_outputs" "from_state" {
+  stack_id = "your-stack-id"
+  source   = "state"
+}
+
+# Fail instead of reading outputs from a drifted stack
+data "spaceliftoutput_stack_outputs" "not_drifted" {
+  stack_id      = "your-stack-id"
+  fail_on_drift = true
+}
+
+# Pin to the outputs of a known-good deployment
+data "spaceliftoutput_stack_outputs" "pinned" {
+  stack_id   = "your-stack-id"
+  commit_sha = "3f2a9c1"
+}
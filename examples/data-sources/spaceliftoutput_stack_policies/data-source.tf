@@ -0,0 +1,19 @@
+data "spaceliftoutput_policies" "compliance" {
+  types  = ["PLAN", "APPROVAL"]
+  labels = ["compliance"]
+}
+
+data "spaceliftoutput_stack_policies" "network" {
+  stack_id = "network"
+}
+
+# Fail the plan if a compliance policy is not attached to the stack
+check "network_compliance_policies" {
+  assert {
+    condition = length(setsubtract(
+      data.spaceliftoutput_policies.compliance.policies[*].id,
+      data.spaceliftoutput_stack_policies.network.policy_ids,
+    )) == 0
+    error_message = "The network stack is missing compliance policies."
+  }
+}
@@ -0,0 +1,9 @@
+data "spaceliftoutput_stack_resources" "subnets" {
+  stack_id       = "network"
+  types          = ["aws_subnet"]
+  address_prefix = "module.vpc."
+}
+
+output "drifted_subnets" {
+  value = [for r in data.spaceliftoutput_stack_resources.subnets.resources : r.address if r.drifted]
+}
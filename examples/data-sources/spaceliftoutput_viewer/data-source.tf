@@ -0,0 +1,15 @@
+data "spaceliftoutput_viewer" "current" {}
+
+# Reject plans that are not run with an API key
+resource "terraform_data" "require_api_key" {
+  lifecycle {
+    precondition {
+      condition     = data.spaceliftoutput_viewer.current.login_method == "API_KEY"
+      error_message = "Outputs must be read with an API key, not a personal token."
+    }
+  }
+}
+
+output "viewer_name" {
+  value = data.spaceliftoutput_viewer.current.name
+}
@@ -0,0 +1,11 @@
+data "spaceliftoutput_worker_pool" "prod" {
+  name = "prod-private-workers"
+}
+
+data "spaceliftoutput_worker_pool" "by_id" {
+  worker_pool_id = "01HZX7K8WQ4N3V2B1M0P9R8S7T"
+}
+
+output "prod_worker_pool_id" {
+  value = data.spaceliftoutput_worker_pool.prod.id
+}
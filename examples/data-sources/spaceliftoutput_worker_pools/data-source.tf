@@ -0,0 +1,6 @@
+data "spaceliftoutput_worker_pools" "all" {}
+
+# Worker pools with no worker accepting runs
+output "offline_worker_pools" {
+  value = [for pool in data.spaceliftoutput_worker_pools.all.worker_pools : pool.name if pool.status == "OFFLINE"]
+}
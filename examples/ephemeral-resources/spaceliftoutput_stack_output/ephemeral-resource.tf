@@ -0,0 +1,11 @@
+ephemeral "spaceliftoutput_stack_output" "db_password" {
+  stack_id    = "database-prod"
+  output_name = "admin_password"
+}
+
+# The password is only held in memory and never written to plan or state files
+provider "postgresql" {
+  host     = "db.example.com"
+  username = "admin"
+  password = ephemeral.spaceliftoutput_stack_output.db_password.value
+}
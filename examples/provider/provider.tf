@@ -9,6 +9,7 @@ terraform {
 provider "spaceliftoutput" {
   # Configuration options
   # api_token = "your-spacelift-api-token" # or use SPACELIFT_API_TOKEN env var
+  # api_token_file = "/var/run/secrets/spacelift/token" # or use SPACELIFT_API_TOKEN_FILE env var, re-read when rotated
   # account_name = "your-account-name" # optional, defaults to eaglespirittech or use spacelift_account_name env var
   # api_url = "https://your-account.app.spacelift.io/graphql" # optional, constructed from account_name if not provided
 } 
\ No newline at end of file
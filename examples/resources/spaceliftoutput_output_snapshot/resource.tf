@@ -0,0 +1,21 @@
+# Pin the network outputs until the next release is rolled out
+resource "spaceliftoutput_output_snapshot" "network" {
+  stack_id = "network"
+
+  triggers = {
+    release = var.release
+  }
+}
+
+# Warn in plans when the pinned outputs are stale
+check "network_snapshot_current" {
+  assert {
+    condition     = !spaceliftoutput_output_snapshot.network.upstream_changed
+    error_message = "The network outputs changed since ${spaceliftoutput_output_snapshot.network.captured_at}. Change the release to pick them up."
+  }
+}
+
+module "app" {
+  source = "./app"
+  vpc_id = spaceliftoutput_output_snapshot.network.outputs["vpc_id"]
+}
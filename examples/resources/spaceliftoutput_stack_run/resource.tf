@@ -0,0 +1,15 @@
+# Deploy the shared network of a preview environment before its services
+resource "spaceliftoutput_stack_run" "network" {
+  stack_id     = "preview-network"
+  auto_confirm = true
+  timeout      = "45m"
+
+  triggers = {
+    environment = var.environment
+  }
+}
+
+module "services" {
+  source = "./services"
+  vpc_id = spaceliftoutput_stack_run.network.outputs["vpc_id"]
+}
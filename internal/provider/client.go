@@ -3,22 +3,46 @@ package provider
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// defaultHTTPTimeout bounds each HTTP request made by a SpaceLiftClient
+// without a configured HTTPClient.
+const defaultHTTPTimeout = time.Minute
+
+// errUnauthorized is returned when the SpaceLift API rejects the API token.
+var errUnauthorized = errors.New("unauthorized: the SpaceLift API rejected the API token")
+
 // SpaceLiftClient is the client used to communicate with the SpaceLift API.
 type SpaceLiftClient struct {
 	ApiToken string
-	ApiUrl   string
+	// ApiTokenFile is the path of a file holding the API token. When set, the
+	// token is re-read whenever the file changes or the API answers with a 401.
+	ApiTokenFile string
+	ApiUrl       string
+	// HTTPClient sends requests to the SpaceLift API and downloads state. If
+	// nil, a client with defaultHTTPTimeout is used.
+	HTTPClient *http.Client
+	// DebugDump enables logging of raw response bodies. Credentials are still
+	// masked, but output values are not, so this is off by default.
+	DebugDump bool
 	// For testing purposes
 	mockOutputs map[string][]StackOutput
 	ctx         context.Context
+
+	tokenMu      sync.Mutex
+	tokenModTime time.Time
 }
 
 // GraphQLRequest represents a GraphQL request.
@@ -29,8 +53,8 @@ type GraphQLRequest struct {
 
 // GraphQLResponse represents a GraphQL response.
 type GraphQLResponse struct {
-	Data   map[string]interface{} `json:"data,omitempty"`
-	Errors []GraphQLError         `json:"errors,omitempty"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
 }
 
 // GraphQLError represents a GraphQL error.
@@ -38,54 +62,60 @@ type GraphQLError struct {
 	Message string `json:"message"`
 }
 
-// StackOutput represents a stack output.
-type StackOutput struct {
-	ID    string `json:"id"`
-	Value string `json:"value"`
+// readTokenFile reads an API token from the given file, trimming surrounding whitespace.
+func readTokenFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading API token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("API token file %s is empty", path)
+	}
+
+	return token, nil
 }
 
-// GetStackOutputs retrieves the outputs for a stack.
-func (c *SpaceLiftClient) GetStackOutputs(stackID string) ([]StackOutput, error) {
-	tflog.Debug(c.ctx, "Getting stack outputs", map[string]interface{}{
-		"stack_id": stackID,
-	})
+// token returns the API token to authenticate with. When the client reads its
+// token from a file, the file is re-read if it changed since the last read or
+// if reload is true.
+func (c *SpaceLiftClient) token(reload bool) (string, error) {
+	if c.ApiTokenFile == "" {
+		return c.ApiToken, nil
+	}
 
-	// For testing purposes
-	if c.mockOutputs != nil {
-		tflog.Debug(c.ctx, "Using mock outputs", map[string]interface{}{
-			"stack_id": stackID,
-		})
-		if outputs, ok := c.mockOutputs[stackID]; ok {
-			return outputs, nil
-		}
-		// If the stack ID is not found in the mock outputs, return default mock outputs
-		return []StackOutput{
-			{
-				ID:    "output1",
-				Value: fmt.Sprintf("value1-for-%s", stackID),
-			},
-			{
-				ID:    "output2",
-				Value: fmt.Sprintf("value2-for-%s", stackID),
-			},
-		}, nil
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	info, err := os.Stat(c.ApiTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("error reading API token file: %w", err)
 	}
 
-	query := `
-		query getStackOutputs($id: ID!) {
-			stack(id: $id) {
-				outputs {
-					id
-					value
-				}
-			}
-		}
-	`
+	if !reload && c.ApiToken != "" && info.ModTime().Equal(c.tokenModTime) {
+		return c.ApiToken, nil
+	}
 
-	variables := map[string]interface{}{
-		"id": stackID,
+	token, err := readTokenFile(c.ApiTokenFile)
+	if err != nil {
+		return "", err
 	}
 
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Loaded API token from file", map[string]interface{}{
+		"path": c.ApiTokenFile,
+	})
+
+	c.ApiToken = token
+	c.tokenModTime = info.ModTime()
+
+	return token, nil
+}
+
+// executeQuery sends a GraphQL query to the SpaceLift API and decodes the
+// response data into data. If the API rejects a token read from a file, the
+// file is re-read and the request retried once.
+func (c *SpaceLiftClient) executeQuery(query string, variables map[string]interface{}, data interface{}) error {
 	request := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -93,78 +123,206 @@ func (c *SpaceLiftClient) GetStackOutputs(stackID string) ([]StackOutput, error)
 
 	requestBody, err := json.Marshal(request)
 	if err != nil {
-		tflog.Error(c.ctx, "Failed to marshal request", map[string]interface{}{
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to marshal request", map[string]interface{}{
 			"error": err.Error(),
 		})
-		return nil, fmt.Errorf("error marshalling request: %w", err)
+		return fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	token, err := c.token(false)
+	if err != nil {
+		return err
+	}
+
+	statusCode, body, err := c.post(requestBody, token)
+	if err != nil {
+		return err
+	}
+
+	if statusCode == http.StatusUnauthorized && c.ApiTokenFile != "" {
+		tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "SpaceLift API returned 401, reloading API token file", map[string]interface{}{
+			"path": c.ApiTokenFile,
+		})
+
+		token, err = c.token(true)
+		if err != nil {
+			return err
+		}
+
+		statusCode, body, err = c.post(requestBody, token)
+		if err != nil {
+			return err
+		}
 	}
 
+	if statusCode == http.StatusUnauthorized {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "SpaceLift API rejected the API token")
+		return errUnauthorized
+	}
+
+	var graphQLResponse GraphQLResponse
+	err = json.Unmarshal(body, &graphQLResponse)
+	if err != nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to unmarshal response", map[string]interface{}{
+			"error":       err.Error(),
+			"status_code": statusCode,
+		})
+		return fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	if len(graphQLResponse.Errors) > 0 {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "GraphQL error in response", map[string]interface{}{
+			"error": graphQLResponse.Errors[0].Message,
+		})
+		return fmt.Errorf("GraphQL error: %s", graphQLResponse.Errors[0].Message)
+	}
+
+	if len(graphQLResponse.Data) == 0 {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Invalid response format", map[string]interface{}{
+			"error": "data not found",
+		})
+		return fmt.Errorf("invalid response format: data not found")
+	}
+
+	if err := json.Unmarshal(graphQLResponse.Data, data); err != nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to unmarshal response data", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return fmt.Errorf("error unmarshalling response data: %w", err)
+	}
+
+	return nil
+}
+
+// httpClient returns the HTTP client to send requests with.
+func (c *SpaceLiftClient) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return &http.Client{Timeout: defaultHTTPTimeout}
+}
+
+// post sends a request body to the SpaceLift API and returns the status code and response body.
+func (c *SpaceLiftClient) post(requestBody []byte, token string) (int, []byte, error) {
 	req, err := http.NewRequest("POST", c.ApiUrl, bytes.NewBuffer(requestBody))
 	if err != nil {
-		tflog.Error(c.ctx, "Failed to create request", map[string]interface{}{
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to create request", map[string]interface{}{
 			"error": err.Error(),
 			"url":   c.ApiUrl,
 		})
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return 0, nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+c.ApiToken)
+	req.Header.Set("Authorization", "Bearer "+token)
 
-	tflog.Debug(c.ctx, "Sending request to SpaceLift API", map[string]interface{}{
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Sending request to SpaceLift API", map[string]interface{}{
 		"url": c.ApiUrl,
 	})
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient().Do(req)
 	if err != nil {
-		tflog.Error(c.ctx, "Failed to make request", map[string]interface{}{
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to make request", map[string]interface{}{
 			"error": err.Error(),
 		})
-		return nil, fmt.Errorf("error making request: %w", err)
+		return 0, nil, fmt.Errorf("error making request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		tflog.Error(c.ctx, "Failed to read response body", map[string]interface{}{
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to read response body", map[string]interface{}{
 			"error": err.Error(),
 		})
-		return nil, fmt.Errorf("error reading response body: %w", err)
+		return 0, nil, fmt.Errorf("error reading response body: %w", err)
 	}
 
-	var graphQLResponse GraphQLResponse
-	err = json.Unmarshal(body, &graphQLResponse)
-	if err != nil {
-		tflog.Error(c.ctx, "Failed to unmarshal response", map[string]interface{}{
-			"error": err.Error(),
-			"body":  string(body),
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Received response from SpaceLift API", map[string]interface{}{
+		"status_code": resp.StatusCode,
+	})
+
+	if c.DebugDump {
+		tflog.SubsystemTrace(c.ctx, clientLogSubsystem, "SpaceLift API response body", map[string]interface{}{
+			"response_body": string(body),
 		})
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
 	}
 
-	if len(graphQLResponse.Errors) > 0 {
-		tflog.Error(c.ctx, "GraphQL error in response", map[string]interface{}{
-			"error": graphQLResponse.Errors[0].Message,
+	return resp.StatusCode, body, nil
+}
+
+// StackOutput represents a stack output.
+type StackOutput struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+	// Type is the JSON-encoded Terraform type of the output, such as "string"
+	// or ["list","string"]. It is only set for outputs read from state.
+	Type string `json:"type,omitempty"`
+	// Sensitive is only set for outputs read from state.
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// GetStackOutputs retrieves the outputs for a stack.
+func (c *SpaceLiftClient) GetStackOutputs(stackID string) ([]StackOutput, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack outputs", map[string]interface{}{
+		"stack_id": stackID,
+	})
+
+	// For testing purposes
+	if c.mockOutputs != nil {
+		tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Using mock outputs", map[string]interface{}{
+			"stack_id": stackID,
 		})
-		return nil, fmt.Errorf("GraphQL error: %s", graphQLResponse.Errors[0].Message)
+		if outputs, ok := c.mockOutputs[stackID]; ok {
+			return outputs, nil
+		}
+		// If the stack ID is not found in the mock outputs, return default mock outputs
+		return []StackOutput{
+			{
+				ID:    "output1",
+				Value: fmt.Sprintf("value1-for-%s", stackID),
+			},
+			{
+				ID:    "output2",
+				Value: fmt.Sprintf("value2-for-%s", stackID),
+			},
+		}, nil
+	}
+
+	query := `
+		query getStackOutputs($id: ID!) {
+			stack(id: $id) {
+				outputs {
+					id
+					value
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": stackID,
+	}
+
+	var data map[string]interface{}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
 	}
 
 	// Extract the stack outputs from the response
-	stackData, ok := graphQLResponse.Data["stack"].(map[string]interface{})
+	stackData, ok := data["stack"].(map[string]interface{})
 	if !ok {
-		tflog.Error(c.ctx, "Invalid response format", map[string]interface{}{
-			"error": "stack data not found",
-			"data":  graphQLResponse.Data,
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Invalid response format", map[string]interface{}{
+			"error":    "stack data not found",
+			"stack_id": stackID,
 		})
 		return nil, fmt.Errorf("invalid response format: stack data not found")
 	}
 
 	outputsData, ok := stackData["outputs"].([]interface{})
 	if !ok {
-		tflog.Error(c.ctx, "Invalid response format", map[string]interface{}{
-			"error":     "outputs data not found",
-			"stackData": stackData,
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Invalid response format", map[string]interface{}{
+			"error":    "outputs data not found",
+			"stack_id": stackID,
 		})
 		return nil, fmt.Errorf("invalid response format: outputs data not found")
 	}
@@ -173,22 +331,23 @@ func (c *SpaceLiftClient) GetStackOutputs(stackID string) ([]StackOutput, error)
 	for _, outputData := range outputsData {
 		outputMap, ok := outputData.(map[string]interface{})
 		if !ok {
-			tflog.Error(c.ctx, "Invalid output format", nil)
+			tflog.SubsystemError(c.ctx, clientLogSubsystem, "Invalid output format", nil)
 			return nil, fmt.Errorf("invalid output format")
 		}
 
 		id, ok := outputMap["id"].(string)
 		if !ok {
-			tflog.Error(c.ctx, "Invalid output id format", map[string]interface{}{
-				"output": outputMap,
+			tflog.SubsystemError(c.ctx, clientLogSubsystem, "Invalid output id format", map[string]interface{}{
+				"stack_id": stackID,
 			})
 			return nil, fmt.Errorf("invalid output id format")
 		}
 
 		value, ok := outputMap["value"].(string)
 		if !ok {
-			tflog.Error(c.ctx, "Invalid output value format", map[string]interface{}{
-				"output": outputMap,
+			tflog.SubsystemError(c.ctx, clientLogSubsystem, "Invalid output value format", map[string]interface{}{
+				"stack_id":  stackID,
+				"output_id": id,
 			})
 			return nil, fmt.Errorf("invalid output value format")
 		}
@@ -199,7 +358,7 @@ func (c *SpaceLiftClient) GetStackOutputs(stackID string) ([]StackOutput, error)
 		})
 	}
 
-	tflog.Debug(c.ctx, "Successfully retrieved stack outputs", map[string]interface{}{
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack outputs", map[string]interface{}{
 		"stack_id":     stackID,
 		"output_count": len(outputs),
 	})
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// configTypeEnvironmentVariable is the type of config elements exposed as environment variables.
+	configTypeEnvironmentVariable = "ENVIRONMENT_VARIABLE"
+	// configTypeFileMount is the type of config elements mounted as files.
+	configTypeFileMount = "FILE_MOUNT"
+)
+
+// configElementFields are the fields selected for each config element.
+const configElementFields = `
+	id
+	type
+	value
+	writeOnly
+	description
+`
+
+// ConfigElement represents an environment variable or mounted file. Value is
+// nil for write-only elements, as the API never returns their values.
+type ConfigElement struct {
+	ID          string  `json:"id"`
+	Type        string  `json:"type"`
+	Value       *string `json:"value"`
+	WriteOnly   bool    `json:"writeOnly"`
+	Description *string `json:"description"`
+}
+
+// SpaceLiftContext represents a SpaceLift context.
+type SpaceLiftContext struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description *string         `json:"description"`
+	Space       string          `json:"space"`
+	Labels      []string        `json:"labels"`
+	Config      []ConfigElement `json:"config"`
+}
+
+// GetContext retrieves a context along with its environment variables and mounted files.
+func (c *SpaceLiftClient) GetContext(contextID string) (*SpaceLiftContext, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting context", map[string]interface{}{
+		"context_id": contextID,
+	})
+
+	query := `
+		query getContext($id: ID!) {
+			context(id: $id) {
+				id
+				name
+				description
+				space
+				labels
+				config {` + configElementFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": contextID,
+	}
+
+	var data struct {
+		Context *SpaceLiftContext `json:"context"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Context == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Context not found", map[string]interface{}{
+			"context_id": contextID,
+		})
+		return nil, fmt.Errorf("context %s not found", contextID)
+	}
+
+	redactWriteOnly(data.Context.Config)
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved context", map[string]interface{}{
+		"context_id":   contextID,
+		"config_count": len(data.Context.Config),
+	})
+
+	return data.Context, nil
+}
+
+// redactWriteOnly clears the values of write-only elements, so they are never
+// surfaced even if the API returns them.
+func redactWriteOnly(elements []ConfigElement) {
+	for i := range elements {
+		if elements[i].WriteOnly {
+			elements[i].Value = nil
+		}
+	}
+}
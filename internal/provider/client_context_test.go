@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceLiftClientGetContext(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "shared-network", request.Variables["id"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"context": map[string]interface{}{
+				"id":     "shared-network",
+				"name":   "Shared network",
+				"space":  "root",
+				"labels": []string{"network"},
+				"config": []map[string]interface{}{
+					{"id": "VPC_CIDR", "type": configTypeEnvironmentVariable, "value": "10.0.0.0/16", "writeOnly": false},
+					{"id": "DB_PASSWORD", "type": configTypeEnvironmentVariable, "value": "leaked", "writeOnly": true},
+				},
+			},
+		})
+	})
+
+	spaceliftContext, err := client.GetContext("shared-network")
+	require.NoError(t, err)
+	assert.Equal(t, "Shared network", spaceliftContext.Name)
+	assert.Equal(t, []string{"network"}, spaceliftContext.Labels)
+	require.Len(t, spaceliftContext.Config, 2)
+	assert.Equal(t, "10.0.0.0/16", *spaceliftContext.Config[0].Value)
+	assert.True(t, spaceliftContext.Config[1].WriteOnly)
+	assert.Nil(t, spaceliftContext.Config[1].Value)
+}
+
+func TestSpaceLiftClientGetContextNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"context": nil})
+	})
+
+	_, err := client.GetContext("missing")
+	assert.EqualError(t, err, "context missing not found")
+}
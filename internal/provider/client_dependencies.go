@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// StackReference identifies a stack by ID and name.
+type StackReference struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// StackDependencyReference maps an output of the upstream stack to an input of the downstream stack.
+type StackDependencyReference struct {
+	ID         string `json:"id"`
+	OutputName string `json:"outputName"`
+	InputName  string `json:"inputName"`
+}
+
+// StackDependency represents a dependency of Stack on DependsOnStack.
+type StackDependency struct {
+	ID             string                     `json:"id"`
+	Stack          StackReference             `json:"stack"`
+	DependsOnStack StackReference             `json:"dependsOnStack"`
+	References     []StackDependencyReference `json:"references"`
+}
+
+// StackDependencies holds the upstream and downstream dependencies of a stack.
+type StackDependencies struct {
+	DependsOn      []StackDependency `json:"dependsOn"`
+	IsDependedOnBy []StackDependency `json:"isDependedOnBy"`
+}
+
+// GetStackDependencies retrieves the upstream and downstream dependencies of a stack.
+func (c *SpaceLiftClient) GetStackDependencies(stackID string) (*StackDependencies, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack dependencies", map[string]interface{}{
+		"stack_id": stackID,
+	})
+
+	query := `
+		query getStackDependencies($id: ID!) {
+			stack(id: $id) {
+				dependsOn {
+					...stackDependency
+				}
+				isDependedOnBy {
+					...stackDependency
+				}
+			}
+		}
+
+		fragment stackDependency on StackDependency {
+			id
+			stack {
+				id
+				name
+			}
+			dependsOnStack {
+				id
+				name
+			}
+			references {
+				id
+				outputName
+				inputName
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": stackID,
+	}
+
+	var data struct {
+		Stack *StackDependencies `json:"stack"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Stack == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+			"stack_id": stackID,
+		})
+		return nil, fmt.Errorf("stack %s not found", stackID)
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack dependencies", map[string]interface{}{
+		"stack_id":         stackID,
+		"upstream_count":   len(data.Stack.DependsOn),
+		"downstream_count": len(data.Stack.IsDependedOnBy),
+	})
+
+	return data.Stack, nil
+}
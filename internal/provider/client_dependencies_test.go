@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceLiftClientGetStackDependencies(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "app", request.Variables["id"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"dependsOn": []map[string]interface{}{
+					{
+						"id":             "dep-1",
+						"stack":          map[string]interface{}{"id": "app", "name": "App"},
+						"dependsOnStack": map[string]interface{}{"id": "network", "name": "Network"},
+						"references": []map[string]interface{}{
+							{"id": "ref-1", "outputName": "vpc_id", "inputName": "TF_VAR_vpc_id"},
+						},
+					},
+				},
+				"isDependedOnBy": []map[string]interface{}{},
+			},
+		})
+	})
+
+	dependencies, err := client.GetStackDependencies("app")
+	require.NoError(t, err)
+	require.Len(t, dependencies.DependsOn, 1)
+	assert.Empty(t, dependencies.IsDependedOnBy)
+
+	dependency := dependencies.DependsOn[0]
+	assert.Equal(t, StackReference{ID: "network", Name: "Network"}, dependency.DependsOnStack)
+	assert.Equal(t, []StackDependencyReference{{ID: "ref-1", OutputName: "vpc_id", InputName: "TF_VAR_vpc_id"}}, dependency.References)
+}
+
+func TestSpaceLiftClientGetStackDependenciesNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"stack": nil})
+	})
+
+	_, err := client.GetStackDependencies("missing")
+	assert.EqualError(t, err, "stack missing not found")
+}
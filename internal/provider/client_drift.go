@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DriftDetection represents the drift detection configuration of a stack.
+type DriftDetection struct {
+	Reconcile   bool     `json:"reconcile"`
+	IgnoreState bool     `json:"ignoreState"`
+	Schedule    []string `json:"schedule"`
+	Timezone    string   `json:"timezone"`
+}
+
+// GetDriftDetection retrieves the drift detection configuration of a stack. It
+// returns nil if drift detection is not enabled for the stack.
+func (c *SpaceLiftClient) GetDriftDetection(stackID string) (*DriftDetection, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack drift detection", map[string]interface{}{
+		"stack_id": stackID,
+	})
+
+	query := `
+		query getStackDriftDetection($id: ID!) {
+			stack(id: $id) {
+				integrations {
+					driftDetection {
+						reconcile
+						ignoreState
+						schedule
+						timezone
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": stackID,
+	}
+
+	var data struct {
+		Stack *struct {
+			Integrations struct {
+				DriftDetection *DriftDetection `json:"driftDetection"`
+			} `json:"integrations"`
+		} `json:"stack"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Stack == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+			"stack_id": stackID,
+		})
+		return nil, fmt.Errorf("stack %s not found", stackID)
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack drift detection", map[string]interface{}{
+		"stack_id": stackID,
+		"enabled":  data.Stack.Integrations.DriftDetection != nil,
+	})
+
+	return data.Stack.Integrations.DriftDetection, nil
+}
+
+// CountDriftedResources returns the number of resources of a stack that drift
+// detection found to have drifted.
+func (c *SpaceLiftClient) CountDriftedResources(stackID string) (int, error) {
+	resources, err := c.GetStackResources(stackID, ResourceFilter{})
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, resource := range resources {
+		if resource.Drifted {
+			count++
+		}
+	}
+	return count, nil
+}
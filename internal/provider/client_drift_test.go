@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceLiftClientGetDriftDetection(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "network", request.Variables["id"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"integrations": map[string]interface{}{
+					"driftDetection": map[string]interface{}{
+						"reconcile": true,
+						"schedule":  []string{"0 */6 * * *"},
+						"timezone":  "UTC",
+					},
+				},
+			},
+		})
+	})
+
+	driftDetection, err := client.GetDriftDetection("network")
+	require.NoError(t, err)
+	require.NotNil(t, driftDetection)
+	assert.True(t, driftDetection.Reconcile)
+	assert.Equal(t, []string{"0 */6 * * *"}, driftDetection.Schedule)
+}
+
+func TestSpaceLiftClientGetDriftDetectionDisabled(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"integrations": map[string]interface{}{"driftDetection": nil},
+			},
+		})
+	})
+
+	driftDetection, err := client.GetDriftDetection("network")
+	require.NoError(t, err)
+	assert.Nil(t, driftDetection)
+}
+
+func TestSpaceLiftClientCountDriftedResources(t *testing.T) {
+	client := newTestClient(t, stackEntitiesHandler(t))
+
+	count, err := client.CountDriftedResources("network")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// PolicyAttachment is a stack or module a policy is attached to.
+type PolicyAttachment struct {
+	ID        string `json:"id"`
+	StackID   string `json:"stackId"`
+	StackName string `json:"stackName"`
+	IsModule  bool   `json:"isModule"`
+}
+
+// Policy represents a SpaceLift policy.
+type Policy struct {
+	ID             string             `json:"id"`
+	Name           string             `json:"name"`
+	Type           string             `json:"type"`
+	Labels         []string           `json:"labels"`
+	Space          string             `json:"space"`
+	AttachedStacks []PolicyAttachment `json:"attachedStacks"`
+}
+
+// PolicyFilter restricts the policies returned by GetPolicies. Empty fields match all policies.
+type PolicyFilter struct {
+	// Types matches policies of any of the given types, such as PLAN or APPROVAL.
+	Types []string
+	// Labels matches policies carrying all of the given labels.
+	Labels []string
+	// Space is the ID or path of the space the policies must belong to.
+	Space string
+}
+
+// AttachedPolicy is a policy attached to a stack.
+type AttachedPolicy struct {
+	ID         string `json:"id"`
+	PolicyID   string `json:"policyId"`
+	PolicyName string `json:"policyName"`
+	PolicyType string `json:"policyType"`
+}
+
+// GetPolicies retrieves the policies matching filter, sorted by name.
+func (c *SpaceLiftClient) GetPolicies(filter PolicyFilter) ([]Policy, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting policies")
+
+	spaceID := ""
+	if filter.Space != "" {
+		var err error
+		spaceID, err = c.resolveSpaceID(filter.Space)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		query getPolicies {
+			policies {
+				id
+				name
+				type
+				labels
+				space
+				attachedStacks {
+					id
+					stackId
+					stackName
+					isModule
+				}
+			}
+		}
+	`
+
+	var data struct {
+		Policies []Policy `json:"policies"`
+	}
+	if err := c.executeQuery(query, nil, &data); err != nil {
+		return nil, err
+	}
+
+	policies := []Policy{}
+	for _, policy := range data.Policies {
+		if len(filter.Types) > 0 && !containsString(filter.Types, policy.Type) {
+			continue
+		}
+		if spaceID != "" && policy.Space != spaceID {
+			continue
+		}
+		if !containsAllStrings(policy.Labels, filter.Labels) {
+			continue
+		}
+		policies = append(policies, policy)
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved policies", map[string]interface{}{
+		"policy_count": len(data.Policies),
+		"match_count":  len(policies),
+	})
+
+	return policies, nil
+}
+
+// GetStackPolicies retrieves the policies attached to a stack, sorted by policy name.
+func (c *SpaceLiftClient) GetStackPolicies(stackID string) ([]AttachedPolicy, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack policies", map[string]interface{}{
+		"stack_id": stackID,
+	})
+
+	query := `
+		query getStackPolicies($id: ID!) {
+			stack(id: $id) {
+				attachedPolicies {
+					id
+					policyId
+					policyName
+					policyType
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": stackID,
+	}
+
+	var data struct {
+		Stack *struct {
+			AttachedPolicies []AttachedPolicy `json:"attachedPolicies"`
+		} `json:"stack"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Stack == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+			"stack_id": stackID,
+		})
+		return nil, fmt.Errorf("stack %s not found", stackID)
+	}
+
+	policies := data.Stack.AttachedPolicies
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].PolicyName < policies[j].PolicyName
+	})
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack policies", map[string]interface{}{
+		"stack_id":     stackID,
+		"policy_count": len(policies),
+	})
+
+	return policies, nil
+}
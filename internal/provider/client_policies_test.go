@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// policiesHandler answers space queries with testSpaces and policy queries with a fixed set of policies.
+func policiesHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+
+		if strings.Contains(request.Query, "spaces") {
+			writeGraphQLData(t, w, map[string]interface{}{"spaces": testSpaces()})
+			return
+		}
+
+		writeGraphQLData(t, w, map[string]interface{}{
+			"policies": []map[string]interface{}{
+				{
+					"id": "require-approval", "name": "Require approval", "type": "APPROVAL", "labels": []string{"compliance"}, "space": "prod-01",
+					"attachedStacks": []map[string]interface{}{{"id": "a1", "stackId": "vpc-prod", "stackName": "vpc"}},
+				},
+				{"id": "deny-public-buckets", "name": "Deny public buckets", "type": "PLAN", "labels": []string{"compliance", "s3"}, "space": "root"},
+				{"id": "notify-slack", "name": "Notify Slack", "type": "NOTIFICATION", "space": "root"},
+			},
+		})
+	}
+}
+
+func TestSpaceLiftClientGetPolicies(t *testing.T) {
+	client := newTestClient(t, policiesHandler(t))
+
+	policies, err := client.GetPolicies(PolicyFilter{})
+	require.NoError(t, err)
+	require.Len(t, policies, 3)
+	assert.Equal(t, "Deny public buckets", policies[0].Name)
+	assert.Equal(t, "Require approval", policies[2].Name)
+	assert.Equal(t, []PolicyAttachment{{ID: "a1", StackID: "vpc-prod", StackName: "vpc"}}, policies[2].AttachedStacks)
+}
+
+func TestSpaceLiftClientGetPoliciesFilter(t *testing.T) {
+	client := newTestClient(t, policiesHandler(t))
+
+	testCases := map[string]struct {
+		filter PolicyFilter
+		want   []string
+	}{
+		"types":  {filter: PolicyFilter{Types: []string{"PLAN", "APPROVAL"}}, want: []string{"deny-public-buckets", "require-approval"}},
+		"labels": {filter: PolicyFilter{Labels: []string{"compliance", "s3"}}, want: []string{"deny-public-buckets"}},
+		"space":  {filter: PolicyFilter{Space: "root/prod"}, want: []string{"require-approval"}},
+		"none":   {filter: PolicyFilter{Types: []string{"LOGIN"}}, want: []string{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			policies, err := client.GetPolicies(tc.filter)
+			require.NoError(t, err)
+
+			ids := []string{}
+			for _, policy := range policies {
+				ids = append(ids, policy.ID)
+			}
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestSpaceLiftClientGetStackPolicies(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "vpc-prod", request.Variables["id"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"attachedPolicies": []map[string]interface{}{
+					{"id": "a2", "policyId": "require-approval", "policyName": "Require approval", "policyType": "APPROVAL"},
+					{"id": "a1", "policyId": "deny-public-buckets", "policyName": "Deny public buckets", "policyType": "PLAN"},
+				},
+			},
+		})
+	})
+
+	policies, err := client.GetStackPolicies("vpc-prod")
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "deny-public-buckets", policies[0].PolicyID)
+	assert.Equal(t, "PLAN", policies[0].PolicyType)
+}
+
+func TestSpaceLiftClientGetStackPoliciesNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"stack": nil})
+	})
+
+	_, err := client.GetStackPolicies("missing")
+	assert.EqualError(t, err, "stack missing not found")
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// entityTypeResource is the SpaceLift entity type of Terraform resources and data sources.
+const entityTypeResource = "resource"
+
+// resourceModeManaged is the Terraform mode of managed resources, as opposed to data sources.
+const resourceModeManaged = "managed"
+
+// StackResource represents a Terraform resource managed by a stack, as
+// tracked by SpaceLift.
+type StackResource struct {
+	ID       string
+	Address  string
+	Name     string
+	Type     string
+	Provider string
+	Drifted  bool
+}
+
+// stackEntity is a SpaceLift entity as returned by the API.
+type stackEntity struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	// Drifted is the time drift was detected, or null if the entity has not drifted.
+	Drifted *int64 `json:"drifted"`
+	Vendor  struct {
+		Terraform *struct {
+			Mode     string `json:"mode"`
+			Type     string `json:"type"`
+			Provider string `json:"provider"`
+		} `json:"terraform"`
+	} `json:"vendor"`
+}
+
+// ResourceFilter restricts the resources returned by GetStackResources. Empty fields match all resources.
+type ResourceFilter struct {
+	Types         []string
+	AddressPrefix string
+}
+
+// matches reports whether the resource satisfies the filter.
+func (f ResourceFilter) matches(resource StackResource) bool {
+	if len(f.Types) > 0 && !containsString(f.Types, resource.Type) {
+		return false
+	}
+	if !strings.HasPrefix(resource.Address, f.AddressPrefix) {
+		return false
+	}
+	return true
+}
+
+// GetStackResources retrieves the managed resources of a stack matching
+// filter, sorted by address.
+func (c *SpaceLiftClient) GetStackResources(stackID string, filter ResourceFilter) ([]StackResource, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack resources", map[string]interface{}{
+		"stack_id": stackID,
+	})
+
+	query := `
+		query getStackEntities($id: ID!) {
+			stack(id: $id) {
+				entities {
+					id
+					address
+					name
+					type
+					drifted
+					vendor {
+						... on EntityVendorTerraform {
+							terraform {
+								... on TerraformResource {
+									mode
+									type
+									provider
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": stackID,
+	}
+
+	var data struct {
+		Stack *struct {
+			Entities []stackEntity `json:"entities"`
+		} `json:"stack"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Stack == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+			"stack_id": stackID,
+		})
+		return nil, fmt.Errorf("stack %s not found", stackID)
+	}
+
+	resources := []StackResource{}
+	for _, entity := range data.Stack.Entities {
+		terraform := entity.Vendor.Terraform
+		if entity.Type != entityTypeResource || terraform == nil || terraform.Mode != resourceModeManaged {
+			continue
+		}
+
+		resource := StackResource{
+			ID:       entity.ID,
+			Address:  entity.Address,
+			Name:     entity.Name,
+			Type:     terraform.Type,
+			Provider: terraform.Provider,
+			Drifted:  entity.Drifted != nil,
+		}
+		if filter.matches(resource) {
+			resources = append(resources, resource)
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Address < resources[j].Address
+	})
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack resources", map[string]interface{}{
+		"stack_id":       stackID,
+		"entity_count":   len(data.Stack.Entities),
+		"resource_count": len(resources),
+	})
+
+	return resources, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stackEntitiesHandler answers every request with a mix of resource, data
+// source and output entities.
+func stackEntitiesHandler(t *testing.T) http.HandlerFunc {
+	terraform := func(mode, resourceType string) map[string]interface{} {
+		return map[string]interface{}{
+			"terraform": map[string]interface{}{
+				"mode":     mode,
+				"type":     resourceType,
+				"provider": `provider["registry.terraform.io/hashicorp/aws"]`,
+			},
+		}
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"entities": []map[string]interface{}{
+					{"id": "e1", "address": "module.vpc.aws_vpc.this", "name": "this", "type": "resource", "vendor": terraform("managed", "aws_vpc")},
+					{"id": "e2", "address": "aws_subnet.private[0]", "name": "private", "type": "resource", "drifted": 1767225600, "vendor": terraform("managed", "aws_subnet")},
+					{"id": "e3", "address": "data.aws_region.current", "name": "current", "type": "resource", "vendor": terraform("data", "aws_region")},
+					{"id": "e4", "address": "vpc_id", "name": "vpc_id", "type": "output", "vendor": map[string]interface{}{"terraform": map[string]interface{}{}}},
+				},
+			},
+		})
+	}
+}
+
+func TestSpaceLiftClientGetStackResources(t *testing.T) {
+	client := newTestClient(t, stackEntitiesHandler(t))
+
+	resources, err := client.GetStackResources("network", ResourceFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []StackResource{
+		{ID: "e2", Address: "aws_subnet.private[0]", Name: "private", Type: "aws_subnet", Provider: `provider["registry.terraform.io/hashicorp/aws"]`, Drifted: true},
+		{ID: "e1", Address: "module.vpc.aws_vpc.this", Name: "this", Type: "aws_vpc", Provider: `provider["registry.terraform.io/hashicorp/aws"]`},
+	}, resources)
+}
+
+func TestSpaceLiftClientGetStackResourcesFilter(t *testing.T) {
+	client := newTestClient(t, stackEntitiesHandler(t))
+
+	resources, err := client.GetStackResources("network", ResourceFilter{Types: []string{"aws_vpc", "aws_subnet"}, AddressPrefix: "module.vpc."})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "module.vpc.aws_vpc.this", resources[0].Address)
+
+	resources, err = client.GetStackResources("network", ResourceFilter{Types: []string{"aws_instance"}})
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+}
+
+func TestSpaceLiftClientGetStackResourcesNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"stack": nil})
+	})
+
+	_, err := client.GetStackResources("missing", ResourceFilter{})
+	assert.EqualError(t, err, "stack missing not found")
+}
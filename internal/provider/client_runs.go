@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// runsPageSize is the number of runs the SpaceLift API returns per page.
+const runsPageSize = 50
+
+// runsMaxPages is the number of pages of run history GetStackRuns scans at
+// most, so that filters matching few or no runs do not page through the whole
+// history of long-lived stacks.
+const runsMaxPages = 20
+
+const (
+	// runStateFinished is the state of runs that completed successfully.
+	runStateFinished = "FINISHED"
+	// runStateUnconfirmed is the state of tracked runs waiting for their plan to be confirmed.
+	runStateUnconfirmed = "UNCONFIRMED"
+)
+
+// runFields are the fields selected for each run.
+const runFields = `
+	id
+	type
+	state
+	branch
+	commit {
+		hash
+		authorName
+		message
+	}
+	triggeredBy
+	createdAt
+	updatedAt
+	finished
+	driftDetection
+`
+
+// RunCommit represents the commit a run was triggered for.
+type RunCommit struct {
+	Hash       string `json:"hash"`
+	AuthorName string `json:"authorName"`
+	Message    string `json:"message"`
+}
+
+// Run represents a SpaceLift run.
+type Run struct {
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	State       string    `json:"state"`
+	Branch      string    `json:"branch"`
+	Commit      RunCommit `json:"commit"`
+	TriggeredBy *string   `json:"triggeredBy"`
+	CreatedAt   int64     `json:"createdAt"`
+	UpdatedAt   int64     `json:"updatedAt"`
+	Finished    bool      `json:"finished"`
+	// DriftDetection is set for proposed runs triggered by drift detection.
+	DriftDetection bool `json:"driftDetection"`
+	// Delta is only populated by GetStackRun.
+	Delta *RunDelta `json:"delta,omitempty"`
+}
+
+// RunDelta holds the number of resources a run added, changed and deleted.
+type RunDelta struct {
+	Added   int64 `json:"added"`
+	Changed int64 `json:"changed"`
+	Deleted int64 `json:"deleted"`
+}
+
+// CreatedTime returns the time the run was created.
+func (r Run) CreatedTime() time.Time {
+	return time.Unix(r.CreatedAt, 0).UTC()
+}
+
+// FinishedTime returns the time the run finished, or the zero time if it is still in progress.
+func (r Run) FinishedTime() time.Time {
+	if !r.Finished {
+		return time.Time{}
+	}
+	return time.Unix(r.UpdatedAt, 0).UTC()
+}
+
+// RunFilter restricts the runs returned by GetStackRuns. Empty fields match all runs.
+type RunFilter struct {
+	States []string
+	Types  []string
+	Branch string
+	// DriftDetection restricts the runs to those triggered by drift detection.
+	DriftDetection bool
+	// CommitSHA matches runs for a commit whose SHA starts with this value.
+	CommitSHA string
+	// Limit is the maximum number of runs to return. Zero means no limit.
+	Limit int
+}
+
+// matches reports whether the run satisfies the filter.
+func (f RunFilter) matches(run Run) bool {
+	if len(f.States) > 0 && !containsString(f.States, run.State) {
+		return false
+	}
+	if len(f.Types) > 0 && !containsString(f.Types, run.Type) {
+		return false
+	}
+	if f.Branch != "" && f.Branch != run.Branch {
+		return false
+	}
+	if f.DriftDetection && !run.DriftDetection {
+		return false
+	}
+	if !strings.HasPrefix(run.Commit.Hash, f.CommitSHA) {
+		return false
+	}
+	return true
+}
+
+// GetStackRuns retrieves the runs of a stack matching filter, newest first.
+// Runs are fetched a page at a time until the limit is reached, the run
+// history is exhausted or runsMaxPages pages have been scanned. The returned
+// flag reports whether the scan stopped at runsMaxPages, in which case older
+// runs were not considered.
+func (c *SpaceLiftClient) GetStackRuns(stackID string, filter RunFilter) ([]Run, bool, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack runs", map[string]interface{}{
+		"stack_id": stackID,
+		"limit":    filter.Limit,
+	})
+
+	query := `
+		query getStackRuns($id: ID!, $before: ID) {
+			stack(id: $id) {
+				runs(before: $before) {` + runFields + `}
+			}
+		}
+	`
+
+	runs := []Run{}
+	truncated := false
+	var before *string
+	for page := 1; page <= runsMaxPages; page++ {
+		variables := map[string]interface{}{
+			"id":     stackID,
+			"before": before,
+		}
+
+		var data struct {
+			Stack *struct {
+				Runs []Run `json:"runs"`
+			} `json:"stack"`
+		}
+		if err := c.executeQuery(query, variables, &data); err != nil {
+			return nil, false, err
+		}
+
+		if data.Stack == nil {
+			tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+				"stack_id": stackID,
+			})
+			return nil, false, fmt.Errorf("stack %s not found", stackID)
+		}
+
+		tflog.SubsystemTrace(c.ctx, clientLogSubsystem, "Retrieved page of stack runs", map[string]interface{}{
+			"stack_id":  stackID,
+			"page":      page,
+			"run_count": len(data.Stack.Runs),
+		})
+
+		for _, run := range data.Stack.Runs {
+			if !filter.matches(run) {
+				continue
+			}
+			runs = append(runs, run)
+			if filter.Limit > 0 && len(runs) == filter.Limit {
+				return runs, false, nil
+			}
+		}
+
+		if len(data.Stack.Runs) < runsPageSize {
+			break
+		}
+		if page == runsMaxPages {
+			tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Stopped scanning stack run history", map[string]interface{}{
+				"stack_id":   stackID,
+				"page_count": page,
+			})
+			truncated = true
+			break
+		}
+		before = &data.Stack.Runs[len(data.Stack.Runs)-1].ID
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack runs", map[string]interface{}{
+		"stack_id":  stackID,
+		"run_count": len(runs),
+		"truncated": truncated,
+	})
+
+	return runs, truncated, nil
+}
+
+// GetStackRun retrieves a single run of a stack, including its resource delta.
+func (c *SpaceLiftClient) GetStackRun(stackID, runID string) (*Run, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack run", map[string]interface{}{
+		"stack_id": stackID,
+		"run_id":   runID,
+	})
+
+	query := `
+		query getStackRun($id: ID!, $runId: ID!) {
+			stack(id: $id) {
+				run(id: $runId) {` + runFields + `
+					delta {
+						added
+						changed
+						deleted
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    stackID,
+		"runId": runID,
+	}
+
+	var data struct {
+		Stack *struct {
+			Run *Run `json:"run"`
+		} `json:"stack"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Stack == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+			"stack_id": stackID,
+		})
+		return nil, fmt.Errorf("stack %s not found", stackID)
+	}
+
+	if data.Stack.Run == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Run not found", map[string]interface{}{
+			"stack_id": stackID,
+			"run_id":   runID,
+		})
+		return nil, fmt.Errorf("run %s not found in stack %s", runID, stackID)
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack run", map[string]interface{}{
+		"stack_id":  stackID,
+		"run_id":    runID,
+		"run_state": data.Stack.Run.State,
+	})
+
+	return data.Stack.Run, nil
+}
+
+// TriggerRun triggers a tracked run of a stack, for commitSHA if it is not
+// empty and for the head of the stack's tracked branch otherwise.
+func (c *SpaceLiftClient) TriggerRun(stackID, commitSHA string) (*Run, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Triggering stack run", map[string]interface{}{
+		"stack_id":   stackID,
+		"commit_sha": commitSHA,
+	})
+
+	query := `
+		mutation triggerRun($stack: ID!, $commitSha: String) {
+			runTrigger(stack: $stack, commitSha: $commitSha, runType: TRACKED) {` + runFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"stack":     stackID,
+		"commitSha": nil,
+	}
+	if commitSHA != "" {
+		variables["commitSha"] = commitSHA
+	}
+
+	var data struct {
+		RunTrigger *Run `json:"runTrigger"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.RunTrigger == nil {
+		return nil, fmt.Errorf("no run was triggered for stack %s", stackID)
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully triggered stack run", map[string]interface{}{
+		"stack_id": stackID,
+		"run_id":   data.RunTrigger.ID,
+	})
+
+	return data.RunTrigger, nil
+}
+
+// ConfirmRun confirms the plan of an unconfirmed tracked run so it is applied.
+func (c *SpaceLiftClient) ConfirmRun(stackID, runID string) (*Run, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Confirming stack run", map[string]interface{}{
+		"stack_id": stackID,
+		"run_id":   runID,
+	})
+
+	query := `
+		mutation confirmRun($stack: ID!, $run: ID!) {
+			runConfirm(stack: $stack, run: $run) {` + runFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"stack": stackID,
+		"run":   runID,
+	}
+
+	var data struct {
+		RunConfirm *Run `json:"runConfirm"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.RunConfirm == nil {
+		return nil, fmt.Errorf("run %s not found in stack %s", runID, stackID)
+	}
+
+	return data.RunConfirm, nil
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRunPages returns a handler serving two pages of runs, alternating
+// between tracked runs on main and proposed runs on a feature branch.
+func testRunPages(t *testing.T, requests *[]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		*requests = append(*requests, request.Variables["before"])
+
+		start := 0
+		if request.Variables["before"] != nil {
+			start = runsPageSize
+		}
+		count := runsPageSize
+		if start > 0 {
+			count = 10
+		}
+
+		runs := []map[string]interface{}{}
+		for i := start; i < start+count; i++ {
+			run := map[string]interface{}{
+				"id":        fmt.Sprintf("run-%d", i),
+				"type":      "TRACKED",
+				"state":     "FINISHED",
+				"branch":    "main",
+				"commit":    map[string]interface{}{"hash": fmt.Sprintf("sha-%d", i)},
+				"createdAt": 1767225600 - i,
+				"updatedAt": 1767225700 - i,
+				"finished":  true,
+			}
+			if i%2 == 1 {
+				run["type"] = "PROPOSED"
+				run["branch"] = "feature"
+			}
+			runs = append(runs, run)
+		}
+
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{"runs": runs},
+		})
+	}
+}
+
+func TestSpaceLiftClientGetStackRunsPaginates(t *testing.T) {
+	var requests []interface{}
+	client := newTestClient(t, testRunPages(t, &requests))
+
+	runs, truncated, err := client.GetStackRuns("network", RunFilter{Types: []string{"TRACKED"}})
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Len(t, runs, 30)
+	assert.Equal(t, []interface{}{nil, "run-49"}, requests)
+	for _, run := range runs {
+		assert.Equal(t, "TRACKED", run.Type)
+	}
+}
+
+func TestSpaceLiftClientGetStackRunsStopsAtLimit(t *testing.T) {
+	var requests []interface{}
+	client := newTestClient(t, testRunPages(t, &requests))
+
+	runs, truncated, err := client.GetStackRuns("network", RunFilter{Branch: "feature", Limit: 3})
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	require.Len(t, runs, 3)
+	assert.Equal(t, "run-1", runs[0].ID)
+	assert.Equal(t, "sha-1", runs[0].Commit.Hash)
+	assert.Len(t, requests, 1)
+}
+
+func TestSpaceLiftClientGetStackRunsStopsAtMaxPages(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		runs := []map[string]interface{}{}
+		for i := 0; i < runsPageSize; i++ {
+			runs = append(runs, map[string]interface{}{
+				"id":    fmt.Sprintf("run-%d-%d", requests, i),
+				"type":  "PROPOSED",
+				"state": "FINISHED",
+			})
+		}
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{"runs": runs},
+		})
+	})
+
+	runs, truncated, err := client.GetStackRuns("network", RunFilter{DriftDetection: true, Limit: 1})
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Empty(t, runs)
+	assert.Equal(t, runsMaxPages, requests)
+}
+
+func TestRunTimes(t *testing.T) {
+	run := Run{CreatedAt: 1767225600, UpdatedAt: 1767225660}
+	assert.Equal(t, "2026-01-01T00:00:00Z", run.CreatedTime().Format("2006-01-02T15:04:05Z07:00"))
+	assert.True(t, run.FinishedTime().IsZero())
+
+	run.Finished = true
+	assert.Equal(t, "2026-01-01T00:01:00Z", run.FinishedTime().Format("2006-01-02T15:04:05Z07:00"))
+}
+
+func TestRunFilterDriftDetection(t *testing.T) {
+	filter := RunFilter{DriftDetection: true}
+	assert.True(t, filter.matches(Run{Type: "PROPOSED", DriftDetection: true}))
+	assert.False(t, filter.matches(Run{Type: "PROPOSED"}))
+	assert.True(t, RunFilter{}.matches(Run{Type: "PROPOSED", DriftDetection: true}))
+}
+
+func TestRunFilterCommitSHA(t *testing.T) {
+	run := Run{Commit: RunCommit{Hash: "abc123def"}}
+	assert.True(t, RunFilter{CommitSHA: "abc123"}.matches(run))
+	assert.True(t, RunFilter{CommitSHA: "abc123def"}.matches(run))
+	assert.False(t, RunFilter{CommitSHA: "def"}.matches(run))
+}
+
+func TestSpaceLiftClientGetStackRun(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "network", request.Variables["id"])
+		assert.Equal(t, "run-1", request.Variables["runId"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"run": map[string]interface{}{
+					"id":       "run-1",
+					"type":     "TRACKED",
+					"state":    "FINISHED",
+					"finished": true,
+					"delta":    map[string]interface{}{"added": 2, "changed": 1, "deleted": 0},
+				},
+			},
+		})
+	})
+
+	run, err := client.GetStackRun("network", "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, "FINISHED", run.State)
+	assert.Equal(t, &RunDelta{Added: 2, Changed: 1}, run.Delta)
+}
+
+func TestSpaceLiftClientGetStackRunNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{"run": nil},
+		})
+	})
+
+	_, err := client.GetStackRun("network", "missing")
+	assert.EqualError(t, err, "run missing not found in stack network")
+}
+
+func TestSpaceLiftClientTriggerRun(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Contains(t, request.Query, "runTrigger")
+		assert.Equal(t, "network", request.Variables["stack"])
+		assert.Equal(t, "abc123", request.Variables["commitSha"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"runTrigger": map[string]interface{}{"id": "run-1", "type": "TRACKED", "state": "QUEUED"},
+		})
+	})
+
+	run, err := client.TriggerRun("network", "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, "run-1", run.ID)
+	assert.Equal(t, "QUEUED", run.State)
+}
+
+func TestSpaceLiftClientTriggerRunWithoutCommit(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Nil(t, request.Variables["commitSha"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"runTrigger": map[string]interface{}{"id": "run-1", "type": "TRACKED", "state": "QUEUED"},
+		})
+	})
+
+	_, err := client.TriggerRun("network", "")
+	require.NoError(t, err)
+}
+
+func TestSpaceLiftClientConfirmRun(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Contains(t, request.Query, "runConfirm")
+		assert.Equal(t, "network", request.Variables["stack"])
+		assert.Equal(t, "run-1", request.Variables["run"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"runConfirm": map[string]interface{}{"id": "run-1", "type": "TRACKED", "state": "CONFIRMED"},
+		})
+	})
+
+	run, err := client.ConfirmRun("network", "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, "CONFIRMED", run.State)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Space represents a SpaceLift space.
+type Space struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	ParentSpace     *string  `json:"parentSpace"`
+	Labels          []string `json:"labels"`
+	InheritEntities bool     `json:"inheritEntities"`
+}
+
+// GetSpaces retrieves all spaces the API token can see.
+func (c *SpaceLiftClient) GetSpaces() ([]Space, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting spaces")
+
+	query := `
+		query getSpaces {
+			spaces {
+				id
+				name
+				description
+				parentSpace
+				labels
+				inheritEntities
+			}
+		}
+	`
+
+	var data struct {
+		Spaces []Space `json:"spaces"`
+	}
+	if err := c.executeQuery(query, nil, &data); err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved spaces", map[string]interface{}{
+		"space_count": len(data.Spaces),
+	})
+
+	return data.Spaces, nil
+}
+
+// spaceTree indexes spaces by ID to resolve their paths.
+type spaceTree map[string]Space
+
+// newSpaceTree builds a spaceTree from a list of spaces.
+func newSpaceTree(spaces []Space) spaceTree {
+	tree := make(spaceTree, len(spaces))
+	for _, space := range spaces {
+		tree[space.ID] = space
+	}
+	return tree
+}
+
+// Path returns the slash-separated path of space names from the root space to
+// the space with the given ID, such as "root/prod/network".
+func (t spaceTree) Path(spaceID string) string {
+	var names []string
+	for id := spaceID; id != ""; {
+		space, ok := t[id]
+		if !ok || len(names) > len(t) {
+			break
+		}
+		names = append([]string{space.Name}, names...)
+		if space.ParentSpace == nil {
+			break
+		}
+		id = *space.ParentSpace
+	}
+	return strings.Join(names, "/")
+}
+
+// topSpaces returns the spaces whose parent is not visible, which is only the
+// root space for tokens that can see it, sorted by ID.
+func (t spaceTree) topSpaces() []Space {
+	var tops []Space
+	for _, space := range t {
+		if space.ParentSpace == nil {
+			tops = append(tops, space)
+			continue
+		}
+		if _, ok := t[*space.ParentSpace]; !ok {
+			tops = append(tops, space)
+		}
+	}
+	sort.Slice(tops, func(i, j int) bool {
+		return tops[i].ID < tops[j].ID
+	})
+	return tops
+}
+
+// FindByPath returns the space at a slash-separated path of space names, such
+// as "root/prod/network". Like the paths returned by Path, the path starts at
+// the root space or, for tokens that cannot see it, at the topmost visible
+// space, such as "prod/network".
+func (t spaceTree) FindByPath(spacePath string) (*Space, error) {
+	names := strings.Split(strings.Trim(spacePath, "/"), "/")
+
+	var starts []Space
+	for _, space := range t.topSpaces() {
+		if space.Name == names[0] {
+			starts = append(starts, space)
+		}
+	}
+
+	switch len(starts) {
+	case 0:
+		return nil, fmt.Errorf("space path %q must start with the root space or a topmost space visible to the API token", spacePath)
+	case 1:
+	default:
+		return nil, fmt.Errorf("multiple topmost spaces named %q are visible to the API token", names[0])
+	}
+
+	current := starts[0]
+	for _, name := range names[1:] {
+		var matches []Space
+		for _, space := range t {
+			if space.ParentSpace != nil && *space.ParentSpace == current.ID && space.Name == name {
+				matches = append(matches, space)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("space %q not found in %q", name, t.Path(current.ID))
+		case 1:
+			current = matches[0]
+		default:
+			return nil, fmt.Errorf("multiple spaces named %q found in %q", name, t.Path(current.ID))
+		}
+	}
+
+	return &current, nil
+}
+
+// Find returns the space with the given ID or, if the value contains a slash or
+// names a topmost space, at the given path.
+func (t spaceTree) Find(idOrPath string) (*Space, error) {
+	if space, ok := t[idOrPath]; ok {
+		return &space, nil
+	}
+
+	if strings.Contains(idOrPath, "/") {
+		return t.FindByPath(idOrPath)
+	}
+	for _, space := range t.topSpaces() {
+		if space.Name == idOrPath {
+			return t.FindByPath(idOrPath)
+		}
+	}
+
+	return nil, fmt.Errorf("space %s not found", idOrPath)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSpaces returns a small space tree: root, root/prod, root/prod/network and root/dev/network.
+func testSpaces() []Space {
+	parent := func(id string) *string { return &id }
+	return []Space{
+		{ID: "root", Name: "root"},
+		{ID: "prod-01", Name: "prod", ParentSpace: parent("root"), InheritEntities: true},
+		{ID: "dev-01", Name: "dev", ParentSpace: parent("root")},
+		{ID: "network-01", Name: "network", ParentSpace: parent("prod-01"), Labels: []string{"team:network"}},
+		{ID: "network-02", Name: "network", ParentSpace: parent("dev-01")},
+	}
+}
+
+func TestSpaceLiftClientGetSpaces(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{
+			"spaces": []map[string]interface{}{
+				{"id": "root", "name": "root", "parentSpace": nil, "inheritEntities": false},
+				{"id": "prod-01", "name": "prod", "parentSpace": "root", "labels": []string{"env:prod"}, "inheritEntities": true},
+			},
+		})
+	})
+
+	spaces, err := client.GetSpaces()
+	require.NoError(t, err)
+	require.Len(t, spaces, 2)
+	assert.Nil(t, spaces[0].ParentSpace)
+	assert.Equal(t, "root", *spaces[1].ParentSpace)
+	assert.True(t, spaces[1].InheritEntities)
+}
+
+func TestSpaceTreePath(t *testing.T) {
+	tree := newSpaceTree(testSpaces())
+
+	assert.Equal(t, "root", tree.Path("root"))
+	assert.Equal(t, "root/prod/network", tree.Path("network-01"))
+	assert.Equal(t, "root/dev/network", tree.Path("network-02"))
+}
+
+func TestSpaceTreeFind(t *testing.T) {
+	tree := newSpaceTree(testSpaces())
+
+	testCases := map[string]struct {
+		idOrPath string
+		wantID   string
+		wantErr  string
+	}{
+		"id":             {idOrPath: "network-01", wantID: "network-01"},
+		"path":           {idOrPath: "root/dev/network", wantID: "network-02"},
+		"root path":      {idOrPath: "root", wantID: "root"},
+		"trailing slash": {idOrPath: "root/prod/", wantID: "prod-01"},
+		"missing space":  {idOrPath: "root/staging", wantErr: `space "staging" not found in "root"`},
+		"missing root":   {idOrPath: "prod/network", wantErr: `space path "prod/network" must start with the root space or a topmost space visible to the API token`},
+		"missing id":     {idOrPath: "staging-01", wantErr: "space staging-01 not found"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			space, err := tree.Find(tc.idOrPath)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantID, space.ID)
+		})
+	}
+}
+
+func TestSpaceTreeFindByPathSubtree(t *testing.T) {
+	// A token that can only see the prod and dev subtrees
+	tree := newSpaceTree(testSpaces()[1:])
+
+	space, err := tree.FindByPath("prod/network")
+	require.NoError(t, err)
+	assert.Equal(t, "network-01", space.ID)
+	assert.Equal(t, "prod/network", tree.Path(space.ID))
+
+	space, err = tree.Find("dev/network")
+	require.NoError(t, err)
+	assert.Equal(t, "network-02", space.ID)
+
+	space, err = tree.Find("dev")
+	require.NoError(t, err)
+	assert.Equal(t, "dev-01", space.ID)
+
+	_, err = tree.FindByPath("root/prod/network")
+	assert.EqualError(t, err, `space path "root/prod/network" must start with the root space or a topmost space visible to the API token`)
+
+	_, err = tree.FindByPath("network")
+	assert.EqualError(t, err, `space path "network" must start with the root space or a topmost space visible to the API token`)
+}
+
+func TestSpaceTreeFindByPathAmbiguous(t *testing.T) {
+	parent := "root"
+	tree := newSpaceTree(append(testSpaces(), Space{ID: "prod-02", Name: "prod", ParentSpace: &parent}))
+
+	_, err := tree.FindByPath("root/prod/network")
+	assert.EqualError(t, err, `multiple spaces named "prod" found in "root"`)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// StackConfigElement is an environment variable or mounted file in the
+// effective environment of a stack, along with where it is defined.
+type StackConfigElement struct {
+	ConfigElement
+	// ContextID is the ID of the attached context defining the element, or
+	// nil if the element is defined on the stack itself.
+	ContextID *string
+}
+
+// attachedContext is a context attached to a stack as returned by the API.
+type attachedContext struct {
+	ContextID string          `json:"contextId"`
+	Priority  int             `json:"priority"`
+	Config    []ConfigElement `json:"config"`
+}
+
+// GetStackEnvironment retrieves the effective environment variables and
+// mounted files of a stack, sorted by name. Elements defined on the stack
+// override those of attached contexts, and contexts with a lower priority
+// number override those with a higher one.
+func (c *SpaceLiftClient) GetStackEnvironment(stackID string) ([]StackConfigElement, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack environment", map[string]interface{}{
+		"stack_id": stackID,
+	})
+
+	query := `
+		query getStackEnvironment($id: ID!) {
+			stack(id: $id) {
+				config {` + configElementFields + `}
+				attachedContexts {
+					contextId
+					priority
+					config {` + configElementFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": stackID,
+	}
+
+	var data struct {
+		Stack *struct {
+			Config           []ConfigElement   `json:"config"`
+			AttachedContexts []attachedContext `json:"attachedContexts"`
+		} `json:"stack"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Stack == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+			"stack_id": stackID,
+		})
+		return nil, fmt.Errorf("stack %s not found", stackID)
+	}
+
+	// Apply contexts from the lowest to the highest precedence, then the stack itself.
+	contexts := data.Stack.AttachedContexts
+	sort.SliceStable(contexts, func(i, j int) bool {
+		return contexts[i].Priority > contexts[j].Priority
+	})
+
+	merged := make(map[string]StackConfigElement)
+	for i := range contexts {
+		redactWriteOnly(contexts[i].Config)
+		for _, element := range contexts[i].Config {
+			merged[element.Type+"/"+element.ID] = StackConfigElement{
+				ConfigElement: element,
+				ContextID:     &contexts[i].ContextID,
+			}
+		}
+	}
+
+	redactWriteOnly(data.Stack.Config)
+	for _, element := range data.Stack.Config {
+		merged[element.Type+"/"+element.ID] = StackConfigElement{ConfigElement: element}
+	}
+
+	elements := make([]StackConfigElement, 0, len(merged))
+	for _, element := range merged {
+		elements = append(elements, element)
+	}
+	sort.Slice(elements, func(i, j int) bool {
+		return elements[i].ID < elements[j].ID
+	})
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved stack environment", map[string]interface{}{
+		"stack_id":      stackID,
+		"context_count": len(contexts),
+		"config_count":  len(elements),
+	})
+
+	return elements, nil
+}
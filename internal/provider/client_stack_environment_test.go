@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceLiftClientGetStackEnvironment(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "network", request.Variables["id"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"config": []map[string]interface{}{
+					{"id": "REGION", "type": configTypeEnvironmentVariable, "value": "eu-west-1"},
+				},
+				"attachedContexts": []map[string]interface{}{
+					{
+						"contextId": "defaults",
+						"priority":  10,
+						"config": []map[string]interface{}{
+							{"id": "REGION", "type": configTypeEnvironmentVariable, "value": "us-east-1"},
+							{"id": "VPC_CIDR", "type": configTypeEnvironmentVariable, "value": "10.0.0.0/8"},
+							{"id": "kubeconfig", "type": configTypeFileMount, "value": "leaked", "writeOnly": true},
+						},
+					},
+					{
+						"contextId": "prod",
+						"priority":  1,
+						"config": []map[string]interface{}{
+							{"id": "VPC_CIDR", "type": configTypeEnvironmentVariable, "value": "10.1.0.0/16"},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	elements, err := client.GetStackEnvironment("network")
+	require.NoError(t, err)
+	require.Len(t, elements, 3)
+
+	assert.Equal(t, "REGION", elements[0].ID)
+	assert.Equal(t, "eu-west-1", *elements[0].Value)
+	assert.Nil(t, elements[0].ContextID)
+
+	assert.Equal(t, "VPC_CIDR", elements[1].ID)
+	assert.Equal(t, "10.1.0.0/16", *elements[1].Value)
+	assert.Equal(t, "prod", *elements[1].ContextID)
+
+	assert.Equal(t, "kubeconfig", elements[2].ID)
+	assert.Nil(t, elements[2].Value)
+	assert.Equal(t, "defaults", *elements[2].ContextID)
+}
+
+func TestSpaceLiftClientGetStackEnvironmentNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"stack": nil})
+	})
+
+	_, err := client.GetStackEnvironment("missing")
+	assert.EqualError(t, err, "stack missing not found")
+}
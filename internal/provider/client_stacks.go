@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// searchStacksPageSize is the number of stacks requested per page when searching stacks.
+const searchStacksPageSize = 50
+
+// stackFields are the fields selected for each stack.
+const stackFields = `
+	id
+	name
+	description
+	space
+	labels
+	state
+	branch
+`
+
+// Stack represents a SpaceLift stack.
+type Stack struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Space       string   `json:"space"`
+	Labels      []string `json:"labels"`
+	State       string   `json:"state"`
+	Branch      string   `json:"branch"`
+}
+
+// stackIdleStates are the stack states in which no tracked run is active.
+var stackIdleStates = []string{"NONE", "FINISHED", "FAILED", "DISCARDED", "STOPPED"}
+
+// Idle reports whether no tracked run is active on the stack. A stack waiting
+// for a tracked run to be confirmed is not idle.
+func (s Stack) Idle() bool {
+	return containsString(stackIdleStates, s.State)
+}
+
+// QueryPredicate is a search predicate on a stack field. A stack matches a
+// predicate if the field matches any of the values.
+type QueryPredicate struct {
+	Field  string
+	Values []string
+}
+
+// SearchStacks retrieves the stacks matching all predicates.
+func (c *SpaceLiftClient) SearchStacks(predicates []QueryPredicate) ([]Stack, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Searching stacks", map[string]interface{}{
+		"predicate_count": len(predicates),
+	})
+
+	query := `
+		query searchStacks($input: SearchInput!) {
+			searchStacks(input: $input) {
+				edges {
+					node {` + stackFields + `}
+				}
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
+			}
+		}
+	`
+
+	inputPredicates := []map[string]interface{}{}
+	for _, predicate := range predicates {
+		inputPredicates = append(inputPredicates, map[string]interface{}{
+			"field": predicate.Field,
+			"constraint": map[string]interface{}{
+				"stringMatches": predicate.Values,
+			},
+		})
+	}
+
+	stacks := []Stack{}
+	var after *string
+	for {
+		variables := map[string]interface{}{
+			"input": map[string]interface{}{
+				"first":      searchStacksPageSize,
+				"after":      after,
+				"predicates": inputPredicates,
+			},
+		}
+
+		var data struct {
+			SearchStacks struct {
+				Edges []struct {
+					Node Stack `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"searchStacks"`
+		}
+		if err := c.executeQuery(query, variables, &data); err != nil {
+			return nil, err
+		}
+
+		for _, edge := range data.SearchStacks.Edges {
+			stacks = append(stacks, edge.Node)
+		}
+
+		if !data.SearchStacks.PageInfo.HasNextPage {
+			break
+		}
+		cursor := data.SearchStacks.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully searched stacks", map[string]interface{}{
+		"stack_count": len(stacks),
+	})
+
+	return stacks, nil
+}
+
+// GetStack retrieves a stack by ID.
+func (c *SpaceLiftClient) GetStack(stackID string) (*Stack, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack", map[string]interface{}{
+		"stack_id": stackID,
+	})
+
+	query := `
+		query getStack($id: ID!) {
+			stack(id: $id) {` + stackFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": stackID,
+	}
+
+	var data struct {
+		Stack *Stack `json:"stack"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Stack == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack not found", map[string]interface{}{
+			"stack_id": stackID,
+		})
+		return nil, fmt.Errorf("stack %s not found", stackID)
+	}
+
+	return data.Stack, nil
+}
+
+// resolveSpaceID returns the ID of the space with the given ID or path.
+func (c *SpaceLiftClient) resolveSpaceID(space string) (string, error) {
+	spaces, err := c.GetSpaces()
+	if err != nil {
+		return "", err
+	}
+
+	found, err := newSpaceTree(spaces).Find(space)
+	if err != nil {
+		return "", err
+	}
+
+	return found.ID, nil
+}
+
+// FindStackByName returns the stack with the given name. If space is not empty,
+// it is the ID or path of the space the stack must belong to. An error is
+// returned unless exactly one stack matches.
+func (c *SpaceLiftClient) FindStackByName(name, space string) (*Stack, error) {
+	predicates := []QueryPredicate{
+		{Field: "name", Values: []string{name}},
+	}
+
+	spaceID := ""
+	if space != "" {
+		var err error
+		spaceID, err = c.resolveSpaceID(space)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, QueryPredicate{Field: "space", Values: []string{spaceID}})
+	}
+
+	stacks, err := c.SearchStacks(predicates)
+	if err != nil {
+		return nil, err
+	}
+
+	// Search predicates may match partially, so only keep exact matches.
+	var matches []Stack
+	for _, stack := range stacks {
+		if stack.Name == name && (spaceID == "" || stack.Space == spaceID) {
+			matches = append(matches, stack)
+		}
+	}
+
+	location := ""
+	if space != "" {
+		location = fmt.Sprintf(" in space %q", space)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no stack named %q found%s", name, location)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, stack := range matches {
+			ids = append(ids, stack.ID)
+		}
+		sort.Strings(ids)
+		return nil, fmt.Errorf("multiple stacks named %q found%s: %s", name, location, strings.Join(ids, ", "))
+	}
+}
+
+// FindStacksByLabels returns the stacks carrying all of the given labels. If
+// space is not empty, it is the ID or path of the space the stacks must belong to.
+func (c *SpaceLiftClient) FindStacksByLabels(labels []string, space string) ([]Stack, error) {
+	var predicates []QueryPredicate
+	for _, label := range labels {
+		predicates = append(predicates, QueryPredicate{Field: "label", Values: []string{label}})
+	}
+
+	spaceID := ""
+	if space != "" {
+		var err error
+		spaceID, err = c.resolveSpaceID(space)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, QueryPredicate{Field: "space", Values: []string{spaceID}})
+	}
+
+	stacks, err := c.SearchStacks(predicates)
+	if err != nil {
+		return nil, err
+	}
+
+	// Search predicates may match partially, so only keep exact matches.
+	var matches []Stack
+	for _, stack := range stacks {
+		if spaceID != "" && stack.Space != spaceID {
+			continue
+		}
+
+		if containsAllStrings(stack.Labels, labels) {
+			matches = append(matches, stack)
+		}
+	}
+
+	return matches, nil
+}
+
+// GetStacksOutputs retrieves the outputs of several stacks concurrently, using
+// at most concurrency requests at a time. The result is keyed by stack ID.
+func (c *SpaceLiftClient) GetStacksOutputs(stackIDs []string, concurrency int) (map[string][]StackOutput, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	type result struct {
+		stackID string
+		outputs []StackOutput
+		err     error
+	}
+
+	jobs := make(chan string)
+	results := make(chan result, len(stackIDs))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(stackIDs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for stackID := range jobs {
+				outputs, err := c.GetStackOutputs(stackID)
+				results <- result{stackID: stackID, outputs: outputs, err: err}
+			}
+		}()
+	}
+
+	for _, stackID := range stackIDs {
+		jobs <- stackID
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+
+	outputs := make(map[string][]StackOutput, len(stackIDs))
+	var errs []error
+	for r := range results {
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("stack %s: %w", r.stackID, r.err))
+			continue
+		}
+		outputs[r.stackID] = r.outputs
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stackSearchHandler serves spaces from testSpaces and answers stack searches
+// with the given stacks, ignoring the search predicates.
+func stackSearchHandler(t *testing.T, stacks ...map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+
+		if strings.Contains(request.Query, "spaces") {
+			spaces := []map[string]interface{}{}
+			for _, space := range testSpaces() {
+				spaces = append(spaces, map[string]interface{}{
+					"id":          space.ID,
+					"name":        space.Name,
+					"parentSpace": space.ParentSpace,
+				})
+			}
+			writeGraphQLData(t, w, map[string]interface{}{"spaces": spaces})
+			return
+		}
+
+		edges := []map[string]interface{}{}
+		for _, stack := range stacks {
+			edges = append(edges, map[string]interface{}{"node": stack})
+		}
+		writeGraphQLData(t, w, map[string]interface{}{
+			"searchStacks": map[string]interface{}{
+				"edges":    edges,
+				"pageInfo": map[string]interface{}{"hasNextPage": false},
+			},
+		})
+	}
+}
+
+func TestSpaceLiftClientSearchStacksPaginates(t *testing.T) {
+	var cursors []interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		input := request.Variables["input"].(map[string]interface{})
+		cursors = append(cursors, input["after"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"field":      "label",
+				"constraint": map[string]interface{}{"stringMatches": []interface{}{"component:network"}},
+			},
+		}, input["predicates"])
+
+		id, hasNextPage := "network-eu", true
+		if input["after"] != nil {
+			id, hasNextPage = "network-us", false
+		}
+		writeGraphQLData(t, w, map[string]interface{}{
+			"searchStacks": map[string]interface{}{
+				"edges": []map[string]interface{}{
+					{"node": map[string]interface{}{"id": id, "name": id}},
+				},
+				"pageInfo": map[string]interface{}{"endCursor": "cursor-1", "hasNextPage": hasNextPage},
+			},
+		})
+	})
+
+	stacks, err := client.SearchStacks([]QueryPredicate{{Field: "label", Values: []string{"component:network"}}})
+	require.NoError(t, err)
+	require.Len(t, stacks, 2)
+	assert.Equal(t, "network-eu", stacks[0].ID)
+	assert.Equal(t, "network-us", stacks[1].ID)
+	assert.Equal(t, []interface{}{nil, "cursor-1"}, cursors)
+}
+
+func TestSpaceLiftClientGetStack(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "vpc-prod", request.Variables["id"])
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{"id": "vpc-prod", "name": "vpc", "space": "network-01", "labels": []string{"component:network"}},
+		})
+	})
+
+	stack, err := client.GetStack("vpc-prod")
+	require.NoError(t, err)
+	assert.Equal(t, "vpc", stack.Name)
+	assert.Equal(t, []string{"component:network"}, stack.Labels)
+}
+
+func TestSpaceLiftClientGetStackNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"stack": nil})
+	})
+
+	_, err := client.GetStack("missing")
+	assert.EqualError(t, err, "stack missing not found")
+}
+
+func TestStackIdle(t *testing.T) {
+	for _, state := range []string{"NONE", "FINISHED", "FAILED", "DISCARDED", "STOPPED"} {
+		assert.True(t, Stack{State: state}.Idle(), state)
+	}
+	for _, state := range []string{"PREPARING", "PLANNING", "UNCONFIRMED", "APPLYING"} {
+		assert.False(t, Stack{State: state}.Idle(), state)
+	}
+}
+
+func TestSpaceLiftClientFindStackByName(t *testing.T) {
+	client := newTestClient(t, stackSearchHandler(t,
+		map[string]interface{}{"id": "vpc-prod", "name": "vpc", "space": "network-01"},
+		map[string]interface{}{"id": "vpc-dev", "name": "vpc", "space": "network-02"},
+		map[string]interface{}{"id": "vpc-peering", "name": "vpc-peering", "space": "network-01"},
+	))
+
+	stack, err := client.FindStackByName("vpc", "root/prod/network")
+	require.NoError(t, err)
+	assert.Equal(t, "vpc-prod", stack.ID)
+
+	stack, err = client.FindStackByName("vpc-peering", "")
+	require.NoError(t, err)
+	assert.Equal(t, "vpc-peering", stack.ID)
+
+	_, err = client.FindStackByName("vpc", "")
+	assert.EqualError(t, err, `multiple stacks named "vpc" found: vpc-dev, vpc-prod`)
+
+	_, err = client.FindStackByName("dns", "root/prod/network")
+	assert.EqualError(t, err, `no stack named "dns" found in space "root/prod/network"`)
+
+	_, err = client.FindStackByName("vpc", "root/staging")
+	assert.EqualError(t, err, `space "staging" not found in "root"`)
+}
+
+func TestSpaceLiftClientFindStacksByLabels(t *testing.T) {
+	client := newTestClient(t, stackSearchHandler(t,
+		map[string]interface{}{"id": "network-eu", "space": "network-01", "labels": []string{"component:network", "region:eu"}},
+		map[string]interface{}{"id": "network-us", "space": "network-01", "labels": []string{"component:network", "region:us"}},
+		map[string]interface{}{"id": "network-dev", "space": "network-02", "labels": []string{"component:network"}},
+		map[string]interface{}{"id": "network-legacy", "space": "network-01", "labels": []string{"component:network-legacy"}},
+	))
+
+	stacks, err := client.FindStacksByLabels([]string{"component:network"}, "root/prod/network")
+	require.NoError(t, err)
+	require.Len(t, stacks, 2)
+	assert.Equal(t, "network-eu", stacks[0].ID)
+	assert.Equal(t, "network-us", stacks[1].ID)
+
+	stacks, err = client.FindStacksByLabels([]string{"component:network", "region:us"}, "")
+	require.NoError(t, err)
+	require.Len(t, stacks, 1)
+	assert.Equal(t, "network-us", stacks[0].ID)
+}
+
+func TestSpaceLiftClientGetStacksOutputs(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		request := decodeGraphQLRequest(t, r)
+		stackID := request.Variables["id"].(string)
+		time.Sleep(10 * time.Millisecond)
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"outputs": []map[string]interface{}{
+					{"id": "vpc_id", "value": "vpc-" + stackID},
+				},
+			},
+		})
+	})
+
+	stackIDs := []string{"network-eu", "network-us", "network-ap", "network-sa", "network-af"}
+	outputs, err := client.GetStacksOutputs(stackIDs, 2)
+	require.NoError(t, err)
+	require.Len(t, outputs, len(stackIDs))
+	for _, stackID := range stackIDs {
+		assert.Equal(t, []StackOutput{{ID: "vpc_id", Value: "vpc-" + stackID}}, outputs[stackID])
+	}
+	assert.LessOrEqual(t, maxInFlight, 2)
+}
+
+func TestSpaceLiftClientGetStacksOutputsError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		if request.Variables["id"] == "broken" {
+			_, _ = w.Write([]byte(`{"errors":[{"message":"stack not found"}]}`))
+			return
+		}
+		stackOutputsHandler(t)(w, r)
+	})
+
+	_, err := client.GetStacksOutputs([]string{"network-eu", "broken"}, 4)
+	assert.EqualError(t, err, "stack broken: GraphQL error: stack not found")
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// terraformState is the subset of a Terraform state file the provider reads.
+type terraformState struct {
+	Version int                             `json:"version"`
+	Outputs map[string]terraformStateOutput `json:"outputs"`
+}
+
+// terraformStateOutput is a root module output in a Terraform state file.
+type terraformStateOutput struct {
+	Value     json.RawMessage `json:"value"`
+	Type      json.RawMessage `json:"type"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+// GetStackStateOutputs retrieves the root module outputs of a stack from its
+// SpaceLift-managed Terraform state.
+func (c *SpaceLiftClient) GetStackStateOutputs(stackID string) ([]StackOutput, error) {
+	return c.getStateOutputs(stackID, "")
+}
+
+// GetStackRunStateOutputs retrieves the root module outputs of a stack from
+// the version of its SpaceLift-managed Terraform state written by a run.
+func (c *SpaceLiftClient) GetStackRunStateOutputs(stackID, runID string) ([]StackOutput, error) {
+	return c.getStateOutputs(stackID, runID)
+}
+
+// getStateOutputs retrieves the root module outputs of the state written by
+// runID, or of the current state if runID is empty.
+func (c *SpaceLiftClient) getStateOutputs(stackID, runID string) ([]StackOutput, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting stack state outputs", map[string]interface{}{
+		"stack_id": stackID,
+		"run_id":   runID,
+	})
+
+	query := `
+		mutation getStateDownloadUrl($input: StateDownloadUrlInput!) {
+			stateDownloadUrl(input: $input) {
+				url
+			}
+		}
+	`
+
+	input := map[string]interface{}{
+		"stackId": stackID,
+	}
+	if runID != "" {
+		input["runId"] = runID
+	}
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var data struct {
+		StateDownloadURL *struct {
+			URL string `json:"url"`
+		} `json:"stateDownloadUrl"`
+	}
+	if err := c.executeQuery(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	if data.StateDownloadURL == nil || data.StateDownloadURL.URL == "" {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "State download URL not found", map[string]interface{}{
+			"stack_id": stackID,
+			"run_id":   runID,
+		})
+		if runID != "" {
+			return nil, fmt.Errorf("no state history found for run %s in stack %s: the stack must use SpaceLift-managed state and the run must have written it", runID, stackID)
+		}
+		return nil, fmt.Errorf("no managed state found for stack %s", stackID)
+	}
+
+	return c.downloadStateOutputs(data.StateDownloadURL.URL)
+}
+
+// downloadStateOutputs downloads a Terraform state file and returns its root module outputs.
+func (c *SpaceLiftClient) downloadStateOutputs(downloadURL string) ([]StackOutput, error) {
+	// The download URL is pre-signed, so it is neither logged nor sent with the API token.
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Downloading managed state")
+
+	resp, err := c.httpClient().Get(downloadURL)
+	if err != nil {
+		// HTTP client errors include the URL, so only their cause is kept
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to download state", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("error downloading state: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Unexpected status downloading state", map[string]interface{}{
+			"status_code": resp.StatusCode,
+		})
+		return nil, fmt.Errorf("error downloading state: unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Failed to read state", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("error reading state: %w", err)
+	}
+
+	return parseStateOutputs(body)
+}
+
+// parseStateOutputs parses a Terraform state file and returns its root module
+// outputs, sorted by name. String values are returned as-is and all other
+// values are JSON-encoded.
+func parseStateOutputs(content []byte) ([]StackOutput, error) {
+	var state terraformState
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("error parsing state: %w", err)
+	}
+
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported state version %d, expected 4", state.Version)
+	}
+
+	outputs := make([]StackOutput, 0, len(state.Outputs))
+	for name, output := range state.Outputs {
+		var value string
+		if err := json.Unmarshal(output.Value, &value); err != nil {
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, output.Value); err != nil {
+				return nil, fmt.Errorf("error parsing output %s: %w", name, err)
+			}
+			value = compact.String()
+		}
+
+		var outputType bytes.Buffer
+		if len(output.Type) > 0 {
+			if err := json.Compact(&outputType, output.Type); err != nil {
+				return nil, fmt.Errorf("error parsing type of output %s: %w", name, err)
+			}
+		}
+
+		outputs = append(outputs, StackOutput{
+			ID:        name,
+			Value:     value,
+			Type:      outputType.String(),
+			Sensitive: output.Sensitive,
+		})
+	}
+
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].ID < outputs[j].ID
+	})
+
+	return outputs, nil
+}
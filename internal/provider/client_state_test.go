@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testState = `{
+	"version": 4,
+	"terraform_version": "1.5.7",
+	"outputs": {
+		"vpc_id": {"value": "vpc-123", "type": "string"},
+		"subnet_ids": {"value": ["subnet-1", "subnet-2"], "type": ["list", "string"]},
+		"db_password": {"value": "hunter2", "type": "string", "sensitive": true}
+	},
+	"resources": []
+}`
+
+func TestParseStateOutputs(t *testing.T) {
+	outputs, err := parseStateOutputs([]byte(testState))
+	require.NoError(t, err)
+
+	assert.Equal(t, []StackOutput{
+		{ID: "db_password", Value: "hunter2", Type: `"string"`, Sensitive: true},
+		{ID: "subnet_ids", Value: `["subnet-1","subnet-2"]`, Type: `["list","string"]`},
+		{ID: "vpc_id", Value: "vpc-123", Type: `"string"`},
+	}, outputs)
+}
+
+func TestParseStateOutputsUnsupportedVersion(t *testing.T) {
+	_, err := parseStateOutputs([]byte(`{"version": 3, "modules": []}`))
+	assert.EqualError(t, err, "unsupported state version 3, expected 4")
+}
+
+func TestSpaceLiftClientGetStackStateOutputs(t *testing.T) {
+	stateServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(testState))
+	}))
+	t.Cleanup(stateServer.Close)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, map[string]interface{}{"stackId": "vpc-prod"}, request.Variables["input"])
+
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stateDownloadUrl": map[string]interface{}{"url": stateServer.URL + "/state.json"},
+		})
+	})
+
+	outputs, err := client.GetStackStateOutputs("vpc-prod")
+	require.NoError(t, err)
+	require.Len(t, outputs, 3)
+	assert.Equal(t, "vpc_id", outputs[2].ID)
+	assert.Equal(t, "vpc-123", outputs[2].Value)
+}
+
+func TestSpaceLiftClientGetStackStateOutputsNoState(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"stateDownloadUrl": nil})
+	})
+
+	_, err := client.GetStackStateOutputs("vpc-prod")
+	assert.EqualError(t, err, "no managed state found for stack vpc-prod")
+}
+
+func TestSpaceLiftClientGetStackRunStateOutputsNoHistory(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, map[string]interface{}{"stackId": "vpc-prod", "runId": "run-1"}, request.Variables["input"])
+		writeGraphQLData(t, w, map[string]interface{}{"stateDownloadUrl": nil})
+	})
+
+	_, err := client.GetStackRunStateOutputs("vpc-prod", "run-1")
+	assert.EqualError(t, err, "no state history found for run run-1 in stack vpc-prod: the stack must use SpaceLift-managed state and the run must have written it")
+}
+
+// failingTransport fails every request with err.
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+func TestSpaceLiftClientDownloadStateOutputsError(t *testing.T) {
+	cause := errors.New("tls: failed to verify certificate")
+	client := newTestClient(t, nil)
+	client.HTTPClient = &http.Client{Transport: failingTransport{err: cause}}
+
+	_, err := client.downloadStateOutputs("https://state.example.com/network.tfstate?X-Amz-Signature=secret")
+	assert.ErrorIs(t, err, cause)
+	assert.EqualError(t, err, "error downloading state: tls: failed to verify certificate")
+}
@@ -1,11 +1,65 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// newTestClient starts a test server with the given handler and returns a
+// client pointed at it.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *SpaceLiftClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &SpaceLiftClient{
+		ApiToken: "test-token",
+		ApiUrl:   server.URL,
+		ctx:      context.Background(),
+	}
+}
+
+// writeGraphQLData writes a GraphQL response with the given data.
+func writeGraphQLData(t *testing.T, w http.ResponseWriter, data interface{}) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	require.NoError(t, err)
+}
+
+// decodeGraphQLRequest decodes the GraphQL request sent to the test server.
+func decodeGraphQLRequest(t *testing.T, r *http.Request) GraphQLRequest {
+	t.Helper()
+
+	var request GraphQLRequest
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+	return request
+}
+
+// stackOutputsHandler answers every request with a single stack output.
+func stackOutputsHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"outputs": []map[string]interface{}{
+					{"id": "vpc_id", "value": "vpc-123"},
+				},
+			},
+		})
+	}
+}
+
 func TestSpaceLiftClientMockOutputs(t *testing.T) {
 	// Create a client with mock outputs
 	t.Skip("This test requires valid Spacelift credentials")
@@ -70,3 +124,88 @@ func TestStackOutput(t *testing.T) {
 	assert.Equal(t, "test-output", output.ID)
 	assert.Equal(t, "test-value", output.Value)
 }
+
+func TestSpaceLiftClientGetStackOutputs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		request := decodeGraphQLRequest(t, r)
+		assert.Equal(t, "my-stack", request.Variables["id"])
+		stackOutputsHandler(t)(w, r)
+	})
+
+	outputs, err := client.GetStackOutputs("my-stack")
+	require.NoError(t, err)
+	assert.Equal(t, []StackOutput{{ID: "vpc_id", Value: "vpc-123"}}, outputs)
+}
+
+func TestSpaceLiftClientGraphQLError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"errors":[{"message":"stack not found"}]}`))
+	})
+
+	_, err := client.GetStackOutputs("missing-stack")
+	assert.EqualError(t, err, "GraphQL error: stack not found")
+}
+
+func TestSpaceLiftClientUnauthorized(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.GetStackOutputs("my-stack")
+	assert.ErrorIs(t, err, errUnauthorized)
+}
+
+func TestSpaceLiftClientTokenFileReloadsOnChange(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("first-token\n"), 0o600))
+
+	var tokens []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		stackOutputsHandler(t)(w, r)
+	})
+	client.ApiToken = ""
+	client.ApiTokenFile = tokenFile
+
+	_, err := client.GetStackOutputs("my-stack")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("second-token"), 0o600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(tokenFile, later, later))
+
+	_, err = client.GetStackOutputs("my-stack")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer first-token", "Bearer second-token"}, tokens)
+}
+
+func TestSpaceLiftClientTokenFileReloadsOnUnauthorized(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("old-token"), 0o600))
+	info, err := os.Stat(tokenFile)
+	require.NoError(t, err)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		stackOutputsHandler(t)(w, r)
+	})
+	client.ApiToken = "old-token"
+	client.ApiTokenFile = tokenFile
+	client.tokenModTime = info.ModTime()
+
+	// Rewrite the file without changing its modification time, so only the
+	// 401 response can trigger the reload.
+	require.NoError(t, os.WriteFile(tokenFile, []byte("new-token"), 0o600))
+	require.NoError(t, os.Chtimes(tokenFile, info.ModTime(), info.ModTime()))
+
+	outputs, err := client.GetStackOutputs("my-stack")
+	require.NoError(t, err)
+	assert.Len(t, outputs, 1)
+	assert.Equal(t, "new-token", client.ApiToken)
+}
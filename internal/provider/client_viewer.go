@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Viewer represents the identity the SpaceLift API token authenticates as.
+type Viewer struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	LoginMethod string   `json:"loginMethod"`
+	Admin       bool     `json:"admin"`
+	Teams       []string `json:"teams"`
+}
+
+// GetViewer retrieves the identity of the current API token.
+func (c *SpaceLiftClient) GetViewer() (*Viewer, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting viewer")
+
+	query := `
+		query getViewer {
+			viewer {
+				id
+				name
+				loginMethod
+				admin
+				teams
+			}
+		}
+	`
+
+	var data struct {
+		Viewer *Viewer `json:"viewer"`
+	}
+	if err := c.executeQuery(query, nil, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Viewer == nil {
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Invalid response format", map[string]interface{}{
+			"error": "viewer data not found",
+		})
+		return nil, fmt.Errorf("invalid response format: viewer data not found")
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved viewer", map[string]interface{}{
+		"viewer_id": data.Viewer.ID,
+	})
+
+	return data.Viewer, nil
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceLiftClientGetViewer(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		assert.Contains(t, request.Query, "viewer")
+		writeGraphQLData(t, w, map[string]interface{}{
+			"viewer": map[string]interface{}{
+				"id":          "ci-key",
+				"name":        "CI key",
+				"loginMethod": "API_KEY",
+				"admin":       false,
+				"teams":       []string{"platform", "readers"},
+			},
+		})
+	})
+
+	viewer, err := client.GetViewer()
+	require.NoError(t, err)
+	assert.Equal(t, &Viewer{
+		ID:          "ci-key",
+		Name:        "CI key",
+		LoginMethod: "API_KEY",
+		Teams:       []string{"platform", "readers"},
+	}, viewer)
+}
+
+func TestSpaceLiftClientGetViewerMissing(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"viewer": nil})
+	})
+
+	_, err := client.GetViewer()
+	assert.EqualError(t, err, "invalid response format: viewer data not found")
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// errPollTimeout is returned by pollUntil when the condition is not met in time.
+var errPollTimeout = errors.New("timed out")
+
+// pollUntil calls check every pollInterval until it reports done, it returns an
+// error, ctx is done or timeout elapses, in which case errPollTimeout is returned.
+func pollUntil(ctx context.Context, timeout, pollInterval time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return errPollTimeout
+		}
+
+		wait := pollInterval
+		if remaining < wait {
+			wait = remaining
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// WaitForStackIdle polls the stack every pollInterval until no tracked run is
+// active, and returns the idle stack. If the stack is still busy after timeout,
+// the last polled stack is returned along with an error naming its state.
+func (c *SpaceLiftClient) WaitForStackIdle(ctx context.Context, stackID string, timeout, pollInterval time.Duration) (*Stack, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Waiting for stack to be idle", map[string]interface{}{
+		"stack_id":      stackID,
+		"timeout":       timeout.String(),
+		"poll_interval": pollInterval.String(),
+	})
+
+	var stack *Stack
+	err := pollUntil(ctx, timeout, pollInterval, func() (bool, error) {
+		var err error
+		stack, err = c.GetStack(stackID)
+		if err != nil {
+			return false, err
+		}
+
+		tflog.SubsystemTrace(c.ctx, clientLogSubsystem, "Polled stack state", map[string]interface{}{
+			"stack_id":    stackID,
+			"stack_state": stack.State,
+		})
+		return stack.Idle(), nil
+	})
+
+	switch {
+	case stack == nil:
+		return nil, err
+	case errors.Is(err, errPollTimeout):
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Timed out waiting for stack to be idle", map[string]interface{}{
+			"stack_id":    stackID,
+			"stack_state": stack.State,
+		})
+		return stack, fmt.Errorf("%w: stack %s is still in state %s after waiting %s for its tracked run to finish", errPollTimeout, stackID, stack.State, timeout)
+	case err != nil:
+		return stack, fmt.Errorf("stopped waiting for stack %s in state %s: %w", stackID, stack.State, err)
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Stack is idle", map[string]interface{}{
+		"stack_id":    stackID,
+		"stack_state": stack.State,
+	})
+
+	return stack, nil
+}
+
+// WaitForRun polls a run every pollInterval until it finishes, confirming it
+// when it waits for confirmation if autoConfirm is set. An error is returned,
+// along with the last polled run, if the run does not finish successfully
+// within timeout.
+func (c *SpaceLiftClient) WaitForRun(ctx context.Context, stackID, runID string, autoConfirm bool, timeout, pollInterval time.Duration) (*Run, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Waiting for stack run to finish", map[string]interface{}{
+		"stack_id":      stackID,
+		"run_id":        runID,
+		"auto_confirm":  autoConfirm,
+		"timeout":       timeout.String(),
+		"poll_interval": pollInterval.String(),
+	})
+
+	var run *Run
+	confirmed := false
+	err := pollUntil(ctx, timeout, pollInterval, func() (bool, error) {
+		var err error
+		run, err = c.GetStackRun(stackID, runID)
+		if err != nil {
+			return false, err
+		}
+
+		tflog.SubsystemTrace(c.ctx, clientLogSubsystem, "Polled stack run state", map[string]interface{}{
+			"stack_id":  stackID,
+			"run_id":    runID,
+			"run_state": run.State,
+		})
+
+		if run.State == runStateUnconfirmed && autoConfirm && !confirmed {
+			if _, err := c.ConfirmRun(stackID, runID); err != nil {
+				return false, fmt.Errorf("could not confirm run %s: %w", runID, err)
+			}
+			confirmed = true
+		}
+
+		return run.Finished, nil
+	})
+
+	switch {
+	case run == nil:
+		return nil, err
+	case errors.Is(err, errPollTimeout):
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Timed out waiting for stack run to finish", map[string]interface{}{
+			"stack_id":  stackID,
+			"run_id":    runID,
+			"run_state": run.State,
+		})
+		return run, fmt.Errorf("%w: run %s in stack %s is still in state %s after waiting %s", errPollTimeout, runID, stackID, run.State, timeout)
+	case err != nil:
+		return run, fmt.Errorf("stopped waiting for run %s in stack %s in state %s: %w", runID, stackID, run.State, err)
+	case run.State != runStateFinished:
+		tflog.SubsystemError(c.ctx, clientLogSubsystem, "Stack run did not finish successfully", map[string]interface{}{
+			"stack_id":  stackID,
+			"run_id":    runID,
+			"run_state": run.State,
+		})
+		return run, fmt.Errorf("run %s in stack %s ended in state %s", runID, stackID, run.State)
+	}
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Stack run finished", map[string]interface{}{
+		"stack_id": stackID,
+		"run_id":   runID,
+	})
+
+	return run, nil
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stackStatesHandler returns a handler serving the stack in each of states in
+// turn, repeating the last state once they are exhausted.
+func stackStatesHandler(t *testing.T, polls *int, states ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		state := states[len(states)-1]
+		if *polls < len(states) {
+			state = states[*polls]
+		}
+		*polls++
+
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{"id": "network", "name": "network", "state": state},
+		})
+	}
+}
+
+func TestSpaceLiftClientWaitForStackIdle(t *testing.T) {
+	var polls int
+	client := newTestClient(t, stackStatesHandler(t, &polls, "PLANNING", "APPLYING", "FINISHED"))
+
+	stack, err := client.WaitForStackIdle(context.Background(), "network", time.Second, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, "FINISHED", stack.State)
+	assert.Equal(t, 3, polls)
+}
+
+func TestSpaceLiftClientWaitForStackIdleAlreadyIdle(t *testing.T) {
+	var polls int
+	client := newTestClient(t, stackStatesHandler(t, &polls, "NONE"))
+
+	stack, err := client.WaitForStackIdle(context.Background(), "network", time.Second, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "NONE", stack.State)
+	assert.Equal(t, 1, polls)
+}
+
+func TestSpaceLiftClientWaitForStackIdleTimeout(t *testing.T) {
+	var polls int
+	client := newTestClient(t, stackStatesHandler(t, &polls, "UNCONFIRMED"))
+
+	stack, err := client.WaitForStackIdle(context.Background(), "network", 20*time.Millisecond, 5*time.Millisecond)
+	require.NotNil(t, stack)
+	assert.Equal(t, "UNCONFIRMED", stack.State)
+	assert.EqualError(t, err, "timed out: stack network is still in state UNCONFIRMED after waiting 20ms for its tracked run to finish")
+	assert.ErrorIs(t, err, errPollTimeout)
+	assert.Greater(t, polls, 1)
+}
+
+func TestSpaceLiftClientWaitForStackIdleCanceled(t *testing.T) {
+	var polls int
+	client := newTestClient(t, stackStatesHandler(t, &polls, "APPLYING"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stack, err := client.WaitForStackIdle(ctx, "network", time.Hour, time.Hour)
+	require.NotNil(t, stack)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, errPollTimeout)
+	assert.Equal(t, 1, polls)
+}
+
+// runStatesHandler returns a handler serving the run in each of states in turn,
+// repeating the last state once they are exhausted, and recording confirmations.
+// A run is served as finished in the FINISHED, FAILED, DISCARDED and STOPPED states.
+func runStatesHandler(t *testing.T, confirmations *int, states ...string) http.HandlerFunc {
+	polls := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		if strings.Contains(request.Query, "runConfirm") {
+			*confirmations++
+			writeGraphQLData(t, w, map[string]interface{}{
+				"runConfirm": map[string]interface{}{"id": "run-1", "state": "CONFIRMED"},
+			})
+			return
+		}
+
+		state := states[len(states)-1]
+		if polls < len(states) {
+			state = states[polls]
+		}
+		polls++
+
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"run": map[string]interface{}{
+					"id":        "run-1",
+					"type":      "TRACKED",
+					"state":     state,
+					"updatedAt": 1767225600,
+					"finished":  containsString([]string{"FINISHED", "FAILED", "DISCARDED", "STOPPED"}, state),
+				},
+			},
+		})
+	}
+}
+
+func TestSpaceLiftClientWaitForRunAutoConfirm(t *testing.T) {
+	var confirmations int
+	client := newTestClient(t, runStatesHandler(t, &confirmations, "PLANNING", "UNCONFIRMED", "UNCONFIRMED", "APPLYING", "FINISHED"))
+
+	run, err := client.WaitForRun(context.Background(), "network", "run-1", true, time.Second, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, "FINISHED", run.State)
+	assert.Equal(t, 1, confirmations)
+}
+
+func TestSpaceLiftClientWaitForRunUnconfirmed(t *testing.T) {
+	var confirmations int
+	client := newTestClient(t, runStatesHandler(t, &confirmations, "UNCONFIRMED"))
+
+	run, err := client.WaitForRun(context.Background(), "network", "run-1", false, 20*time.Millisecond, 5*time.Millisecond)
+	require.NotNil(t, run)
+	assert.EqualError(t, err, "timed out: run run-1 in stack network is still in state UNCONFIRMED after waiting 20ms")
+	assert.ErrorIs(t, err, errPollTimeout)
+	assert.Zero(t, confirmations)
+}
+
+func TestSpaceLiftClientWaitForRunFailed(t *testing.T) {
+	var confirmations int
+	client := newTestClient(t, runStatesHandler(t, &confirmations, "APPLYING", "FAILED"))
+
+	run, err := client.WaitForRun(context.Background(), "network", "run-1", true, time.Second, time.Millisecond)
+	require.NotNil(t, run)
+	assert.Equal(t, "FAILED", run.State)
+	assert.EqualError(t, err, "run run-1 in stack network ended in state FAILED")
+	assert.NotErrorIs(t, err, errPollTimeout)
+}
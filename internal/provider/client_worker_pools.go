@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// workerPoolStatusOffline means the pool has no workers accepting runs.
+	workerPoolStatusOffline = "OFFLINE"
+	// workerPoolStatusBusy means every worker accepting runs is busy.
+	workerPoolStatusBusy = "BUSY"
+	// workerPoolStatusAvailable means at least one worker is idle.
+	workerPoolStatusAvailable = "AVAILABLE"
+)
+
+// Worker represents a worker connected to a private worker pool.
+type Worker struct {
+	ID      string `json:"id"`
+	Busy    bool   `json:"busy"`
+	Drained bool   `json:"drained"`
+}
+
+// WorkerPool represents a SpaceLift private worker pool.
+type WorkerPool struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Space       string   `json:"space"`
+	Labels      []string `json:"labels"`
+	Workers     []Worker `json:"workers"`
+}
+
+// BusyWorkers returns the number of workers processing a run.
+func (p WorkerPool) BusyWorkers() int {
+	count := 0
+	for _, worker := range p.Workers {
+		if worker.Busy {
+			count++
+		}
+	}
+	return count
+}
+
+// IdleWorkers returns the number of workers ready to accept a run. Drained
+// workers do not accept runs and are not counted.
+func (p WorkerPool) IdleWorkers() int {
+	count := 0
+	for _, worker := range p.Workers {
+		if !worker.Busy && !worker.Drained {
+			count++
+		}
+	}
+	return count
+}
+
+// Status summarizes the availability of the pool's workers.
+func (p WorkerPool) Status() string {
+	switch {
+	case p.IdleWorkers() > 0:
+		return workerPoolStatusAvailable
+	case p.BusyWorkers() > 0:
+		return workerPoolStatusBusy
+	default:
+		return workerPoolStatusOffline
+	}
+}
+
+// GetWorkerPools retrieves all private worker pools the API token can see, sorted by name.
+func (c *SpaceLiftClient) GetWorkerPools() ([]WorkerPool, error) {
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Getting worker pools")
+
+	query := `
+		query getWorkerPools {
+			workerPools {
+				id
+				name
+				description
+				space
+				labels
+				workers {
+					id
+					busy
+					drained
+				}
+			}
+		}
+	`
+
+	var data struct {
+		WorkerPools []WorkerPool `json:"workerPools"`
+	}
+	if err := c.executeQuery(query, nil, &data); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(data.WorkerPools, func(i, j int) bool {
+		return data.WorkerPools[i].Name < data.WorkerPools[j].Name
+	})
+
+	tflog.SubsystemDebug(c.ctx, clientLogSubsystem, "Successfully retrieved worker pools", map[string]interface{}{
+		"worker_pool_count": len(data.WorkerPools),
+	})
+
+	return data.WorkerPools, nil
+}
+
+// findWorkerPool returns the pool in pools with the given ID.
+func findWorkerPool(pools []WorkerPool, id string) (*WorkerPool, error) {
+	for i := range pools {
+		if pools[i].ID == id {
+			return &pools[i], nil
+		}
+	}
+	return nil, fmt.Errorf("worker pool %s not found", id)
+}
+
+// findWorkerPoolByName returns the only pool in pools with the given name.
+func findWorkerPoolByName(pools []WorkerPool, name string) (*WorkerPool, error) {
+	var matches []*WorkerPool
+	for i := range pools {
+		if pools[i].Name == name {
+			matches = append(matches, &pools[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no worker pool named %q found", name)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, pool := range matches {
+			ids[i] = pool.ID
+		}
+		sort.Strings(ids)
+		return nil, fmt.Errorf("multiple worker pools named %q found: %s", name, strings.Join(ids, ", "))
+	}
+}
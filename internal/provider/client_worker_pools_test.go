@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWorkerPools returns pools covering each worker pool status.
+func testWorkerPools() []WorkerPool {
+	return []WorkerPool{
+		{ID: "01-prod", Name: "prod", Workers: []Worker{{ID: "w1", Busy: true}, {ID: "w2"}, {ID: "w3", Drained: true}}},
+		{ID: "01-dev", Name: "dev", Workers: []Worker{{ID: "w4", Busy: true}}},
+		{ID: "01-old", Name: "old", Workers: []Worker{{ID: "w5", Drained: true}}},
+		{ID: "01-dup-a", Name: "dup"},
+		{ID: "01-dup-b", Name: "dup"},
+	}
+}
+
+func TestWorkerPoolStatus(t *testing.T) {
+	pools := testWorkerPools()
+
+	assert.Equal(t, 1, pools[0].BusyWorkers())
+	assert.Equal(t, 1, pools[0].IdleWorkers())
+	assert.Equal(t, workerPoolStatusAvailable, pools[0].Status())
+	assert.Equal(t, workerPoolStatusBusy, pools[1].Status())
+	assert.Equal(t, workerPoolStatusOffline, pools[2].Status())
+	assert.Equal(t, workerPoolStatusOffline, pools[3].Status())
+}
+
+func TestSpaceLiftClientGetWorkerPools(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{"workerPools": testWorkerPools()})
+	})
+
+	pools, err := client.GetWorkerPools()
+	require.NoError(t, err)
+	require.Len(t, pools, 5)
+	assert.Equal(t, "dev", pools[0].Name)
+	assert.Equal(t, "prod", pools[4].Name)
+}
+
+func TestFindWorkerPool(t *testing.T) {
+	pools := testWorkerPools()
+
+	pool, err := findWorkerPool(pools, "01-dev")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", pool.Name)
+
+	_, err = findWorkerPool(pools, "missing")
+	assert.EqualError(t, err, "worker pool missing not found")
+}
+
+func TestFindWorkerPoolByName(t *testing.T) {
+	pools := testWorkerPools()
+
+	pool, err := findWorkerPoolByName(pools, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, "01-prod", pool.ID)
+
+	_, err = findWorkerPoolByName(pools, "missing")
+	assert.EqualError(t, err, `no worker pool named "missing" found`)
+
+	_, err = findWorkerPoolByName(pools, "dup")
+	assert.EqualError(t, err, `multiple worker pools named "dup" found: 01-dup-a, 01-dup-b`)
+
+	// The IDs in the error do not depend on the order the API returned the pools in.
+	for i, j := 0, len(pools)-1; i < j; i, j = i+1, j-1 {
+		pools[i], pools[j] = pools[j], pools[i]
+	}
+	_, err = findWorkerPoolByName(pools, "dup")
+	assert.EqualError(t, err, `multiple worker pools named "dup" found: 01-dup-a, 01-dup-b`)
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &contextDataSource{}
+	_ datasource.DataSourceWithConfigure = &contextDataSource{}
+)
+
+// NewContextDataSource is a helper function to simplify the provider implementation.
+func NewContextDataSource() datasource.DataSource {
+	return &contextDataSource{}
+}
+
+// contextDataSource is the data source implementation.
+type contextDataSource struct {
+	client *SpaceLiftClient
+}
+
+// contextDataSourceModel maps the data source schema data.
+type contextDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ContextID            types.String `tfsdk:"context_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Space                types.String `tfsdk:"space"`
+	Labels               types.List   `tfsdk:"labels"`
+	EnvironmentVariables types.List   `tfsdk:"environment_variables"`
+	MountedFiles         types.List   `tfsdk:"mounted_files"`
+	Environment          types.Map    `tfsdk:"environment"`
+}
+
+// configElementModel maps an environment variable or mounted file.
+type configElementModel struct {
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	WriteOnly   types.Bool   `tfsdk:"write_only"`
+	Description types.String `tfsdk:"description"`
+}
+
+// configElementAttrTypes are the attribute types of configElementModel.
+var configElementAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"value":       types.StringType,
+	"write_only":  types.BoolType,
+	"description": types.StringType,
+}
+
+// configElementSchema returns the nested schema of an environment variable or mounted file.
+func configElementSchema(nameDescription string) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: nameDescription,
+				Computed:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The value. Null for write-only (secret) entries, whose values are never revealed.",
+				Computed:    true,
+			},
+			"write_only": schema.BoolAttribute{
+				Description: "Whether the entry is write-only (secret).",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the entry.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// configElementsValue converts the config elements of the given type into a list value.
+func configElementsValue(ctx context.Context, elements []ConfigElement, elementType string) (types.List, diag.Diagnostics) {
+	models := []configElementModel{}
+	for _, element := range elements {
+		if element.Type != elementType {
+			continue
+		}
+		models = append(models, configElementModel{
+			Name:        types.StringValue(element.ID),
+			Value:       types.StringPointerValue(element.Value),
+			WriteOnly:   types.BoolValue(element.WriteOnly),
+			Description: types.StringPointerValue(element.Description),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: configElementAttrTypes}, models)
+}
+
+// environmentValue returns a map of the readable environment variables in elements.
+func environmentValue(ctx context.Context, elements []ConfigElement) (types.Map, diag.Diagnostics) {
+	environment := make(map[string]string)
+	for _, element := range elements {
+		if element.Type != configTypeEnvironmentVariable || element.WriteOnly || element.Value == nil {
+			continue
+		}
+		environment[element.ID] = *element.Value
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, environment)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *contextDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *contextDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_context"
+}
+
+// Schema defines the schema for the data source.
+func (d *contextDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the non-secret configuration of a SpaceLift context.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"context_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift context.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the context.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the context.",
+				Computed:    true,
+			},
+			"space": schema.StringAttribute{
+				Description: "The ID of the space the context belongs to.",
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "The labels of the context.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"environment_variables": schema.ListNestedAttribute{
+				Description:  "The environment variables of the context. Write-only variables are listed without their values.",
+				Computed:     true,
+				NestedObject: configElementSchema("The name of the environment variable."),
+			},
+			"mounted_files": schema.ListNestedAttribute{
+				Description:  "The mounted files of the context. Write-only files are listed without their contents.",
+				Computed:     true,
+				NestedObject: configElementSchema("The path of the mounted file, relative to /mnt/workspace."),
+			},
+			"environment": schema.MapAttribute{
+				Description: "The readable environment variables of the context, keyed by name. Write-only variables are omitted.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contextDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state contextDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contextID := state.ContextID.ValueString()
+	spaceliftContext, err := d.client.GetContext(contextID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Context",
+			"Could not read context: "+err.Error(),
+		)
+		return
+	}
+
+	labels, diags := types.ListValueFrom(ctx, types.StringType, spaceliftContext.Labels)
+	resp.Diagnostics.Append(diags...)
+	environmentVariables, diags := configElementsValue(ctx, spaceliftContext.Config, configTypeEnvironmentVariable)
+	resp.Diagnostics.Append(diags...)
+	mountedFiles, diags := configElementsValue(ctx, spaceliftContext.Config, configTypeFileMount)
+	resp.Diagnostics.Append(diags...)
+	environment, diags := environmentValue(ctx, spaceliftContext.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(spaceliftContext.ID)
+	state.Name = types.StringValue(spaceliftContext.Name)
+	state.Description = types.StringPointerValue(spaceliftContext.Description)
+	state.Space = types.StringValue(spaceliftContext.Space)
+	state.Labels = labels
+	state.EnvironmentVariables = environmentVariables
+	state.MountedFiles = mountedFiles
+	state.Environment = environment
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
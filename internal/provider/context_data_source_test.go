@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContextDataSourceMetadata tests the data source metadata.
+func TestContextDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &contextDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_context", resp.TypeName)
+}
+
+// TestContextDataSourceSchema tests the data source schema.
+func TestContextDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &contextDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"context_id", "name", "labels", "environment_variables", "mounted_files", "environment"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestConfigElementsValue tests that config elements are split by type and
+// write-only values are left out.
+func TestConfigElementsValue(t *testing.T) {
+	ctx := context.Background()
+	value := "10.0.0.0/16"
+	elements := []ConfigElement{
+		{ID: "VPC_CIDR", Type: configTypeEnvironmentVariable, Value: &value},
+		{ID: "DB_PASSWORD", Type: configTypeEnvironmentVariable, WriteOnly: true},
+		{ID: "kubeconfig", Type: configTypeFileMount, WriteOnly: true},
+	}
+
+	environmentVariables, diags := configElementsValue(ctx, elements, configTypeEnvironmentVariable)
+	require.False(t, diags.HasError())
+	var models []configElementModel
+	require.False(t, environmentVariables.ElementsAs(ctx, &models, false).HasError())
+	require.Len(t, models, 2)
+	assert.Equal(t, types.StringValue("10.0.0.0/16"), models[0].Value)
+	assert.True(t, models[1].Value.IsNull())
+
+	mountedFiles, diags := configElementsValue(ctx, elements, configTypeFileMount)
+	require.False(t, diags.HasError())
+	assert.Len(t, mountedFiles.Elements(), 1)
+
+	environment, diags := environmentValue(ctx, elements)
+	require.False(t, diags.HasError())
+	assert.Equal(t, map[string]string{"VPC_CIDR": "10.0.0.0/16"}, mapStrings(t, environment))
+}
+
+// mapStrings converts a map value of strings into a Go map.
+func mapStrings(t *testing.T, value types.Map) map[string]string {
+	t.Helper()
+
+	result := make(map[string]string)
+	require.False(t, value.ElementsAs(context.Background(), &result, false).HasError())
+	return result
+}
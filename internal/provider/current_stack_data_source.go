@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables SpaceLift sets in runs, checked in order.
+var (
+	currentStackIDEnvVars = []string{"TF_VAR_spacelift_stack_id", "SPACELIFT_STACK_ID"}
+	currentRunIDEnvVars   = []string{"TF_VAR_spacelift_run_id", "SPACELIFT_RUN_ID"}
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &currentStackDataSource{}
+	_ datasource.DataSourceWithConfigure = &currentStackDataSource{}
+)
+
+// NewCurrentStackDataSource is a helper function to simplify the provider implementation.
+func NewCurrentStackDataSource() datasource.DataSource {
+	return &currentStackDataSource{}
+}
+
+// currentStackDataSource is the data source implementation.
+type currentStackDataSource struct {
+	client *SpaceLiftClient
+}
+
+// currentStackDataSourceModel maps the data source schema data.
+type currentStackDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	StackID     types.String `tfsdk:"stack_id"`
+	RunID       types.String `tfsdk:"run_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Space       types.String `tfsdk:"space"`
+	Labels      types.List   `tfsdk:"labels"`
+	State       types.String `tfsdk:"state"`
+	Branch      types.String `tfsdk:"branch"`
+	Outputs     types.Map    `tfsdk:"outputs"`
+	LastCheck   types.String `tfsdk:"last_check"`
+}
+
+// lookupFirstEnv returns the value of the first set, non-empty environment variable in names.
+func lookupFirstEnv(names []string) (string, bool) {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *currentStackDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *currentStackDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_stack"
+}
+
+// Schema defines the schema for the data source.
+func (d *currentStackDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the metadata and last-known outputs of the SpaceLift stack running the current Terraform run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the current stack, read from the TF_VAR_spacelift_stack_id or SPACELIFT_STACK_ID environment variable.",
+				Computed:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "The ID of the current run, read from the TF_VAR_spacelift_run_id or SPACELIFT_RUN_ID environment variable. Null if neither is set.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the current stack.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the current stack.",
+				Computed:    true,
+			},
+			"space": schema.StringAttribute{
+				Description: "The ID of the space the current stack belongs to.",
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "The labels of the current stack.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the current stack, such as PREPARING or APPLYING.",
+				Computed:    true,
+			},
+			"branch": schema.StringAttribute{
+				Description: "The branch the current stack tracks.",
+				Computed:    true,
+			},
+			"outputs": schema.MapAttribute{
+				Description: "The outputs of the current stack as of its last successful apply, before the current run.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"last_check": schema.StringAttribute{
+				Description: "The timestamp of the last check.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *currentStackDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state currentStackDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID, ok := lookupFirstEnv(currentStackIDEnvVars)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unable to Determine Current SpaceLift Stack",
+			"Neither the TF_VAR_spacelift_stack_id nor the SPACELIFT_STACK_ID environment variable is set. "+
+				"The spaceliftoutput_current_stack data source can only be read in SpaceLift runs.",
+		)
+		return
+	}
+
+	stack, err := d.client.GetStack(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack",
+			"Could not read the current stack: "+err.Error(),
+		)
+		return
+	}
+
+	outputs, err := d.client.GetStackOutputs(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Outputs",
+			"Could not read stack outputs: "+err.Error(),
+		)
+		return
+	}
+
+	outputMap := make(map[string]attr.Value)
+	for _, output := range outputs {
+		outputMap[output.ID] = types.StringValue(output.Value)
+	}
+
+	outputsValue, diags := types.MapValue(types.StringType, outputMap)
+	resp.Diagnostics.Append(diags...)
+	labels, diags := types.ListValueFrom(ctx, types.StringType, stack.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.RunID = types.StringNull()
+	if runID, ok := lookupFirstEnv(currentRunIDEnvVars); ok {
+		state.RunID = types.StringValue(runID)
+	}
+
+	state.ID = types.StringValue(stack.ID)
+	state.StackID = types.StringValue(stack.ID)
+	state.Name = types.StringValue(stack.Name)
+	state.Description = types.StringPointerValue(stack.Description)
+	state.Space = types.StringValue(stack.Space)
+	state.Labels = labels
+	state.State = types.StringValue(stack.State)
+	state.Branch = types.StringValue(stack.Branch)
+	state.Outputs = outputsValue
+	state.LastCheck = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCurrentStackDataSourceMetadata tests the data source metadata.
+func TestCurrentStackDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &currentStackDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_current_stack", resp.TypeName)
+}
+
+// TestCurrentStackDataSourceSchema tests the data source schema.
+func TestCurrentStackDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &currentStackDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "run_id", "name", "space", "labels", "outputs", "last_check"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestLookupFirstEnv tests that the first non-empty environment variable wins.
+func TestLookupFirstEnv(t *testing.T) {
+	t.Setenv("TF_VAR_spacelift_stack_id", "")
+	t.Setenv("SPACELIFT_STACK_ID", "")
+
+	_, ok := lookupFirstEnv(currentStackIDEnvVars)
+	assert.False(t, ok)
+
+	t.Setenv("SPACELIFT_STACK_ID", "fallback")
+	value, ok := lookupFirstEnv(currentStackIDEnvVars)
+	assert.True(t, ok)
+	assert.Equal(t, "fallback", value)
+
+	t.Setenv("TF_VAR_spacelift_stack_id", "network")
+	value, _ = lookupFirstEnv(currentStackIDEnvVars)
+	assert.Equal(t, "network", value)
+}
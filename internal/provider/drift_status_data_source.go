@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &driftStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &driftStatusDataSource{}
+)
+
+// NewDriftStatusDataSource is a helper function to simplify the provider implementation.
+func NewDriftStatusDataSource() datasource.DataSource {
+	return &driftStatusDataSource{}
+}
+
+// driftStatusDataSource is the data source implementation.
+type driftStatusDataSource struct {
+	client *SpaceLiftClient
+}
+
+// driftStatusDataSourceModel maps the data source schema data.
+type driftStatusDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	StackID          types.String `tfsdk:"stack_id"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Reconcile        types.Bool   `tfsdk:"reconcile"`
+	IgnoreState      types.Bool   `tfsdk:"ignore_state"`
+	Schedule         types.List   `tfsdk:"schedule"`
+	Timezone         types.String `tfsdk:"timezone"`
+	LastRunID        types.String `tfsdk:"last_run_id"`
+	LastRunState     types.String `tfsdk:"last_run_state"`
+	LastRunAt        types.String `tfsdk:"last_run_at"`
+	DriftedResources types.Int64  `tfsdk:"drifted_resources"`
+	Drifted          types.Bool   `tfsdk:"drifted"`
+}
+
+// setDriftDetection sets the drift detection configuration, which is nil if
+// drift detection is not enabled.
+func (m *driftStatusDataSourceModel) setDriftDetection(ctx context.Context, driftDetection *DriftDetection) diag.Diagnostics {
+	m.Enabled = types.BoolValue(driftDetection != nil)
+	if driftDetection == nil {
+		m.Reconcile = types.BoolNull()
+		m.IgnoreState = types.BoolNull()
+		m.Schedule = types.ListNull(types.StringType)
+		m.Timezone = types.StringNull()
+		return nil
+	}
+
+	schedule, diags := types.ListValueFrom(ctx, types.StringType, driftDetection.Schedule)
+	m.Reconcile = types.BoolValue(driftDetection.Reconcile)
+	m.IgnoreState = types.BoolValue(driftDetection.IgnoreState)
+	m.Schedule = schedule
+	m.Timezone = types.StringValue(driftDetection.Timezone)
+	return diags
+}
+
+// setLastRun sets the last drift detection run, which is nil if there is none.
+func (m *driftStatusDataSourceModel) setLastRun(run *Run) {
+	if run == nil {
+		m.LastRunID = types.StringNull()
+		m.LastRunState = types.StringNull()
+		m.LastRunAt = types.StringNull()
+		return
+	}
+
+	m.LastRunID = types.StringValue(run.ID)
+	m.LastRunState = types.StringValue(run.State)
+	m.LastRunAt = types.StringValue(run.CreatedTime().Format(time.RFC3339))
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *driftStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *driftStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_drift_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *driftStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the drift detection configuration and current drift of a SpaceLift stack.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack.",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether drift detection is enabled for the stack.",
+				Computed:    true,
+			},
+			"reconcile": schema.BoolAttribute{
+				Description: "Whether detected drift is reconciled automatically. Null if drift detection is disabled.",
+				Computed:    true,
+			},
+			"ignore_state": schema.BoolAttribute{
+				Description: "Whether drift detection runs regardless of the stack state. Null if drift detection is disabled.",
+				Computed:    true,
+			},
+			"schedule": schema.ListAttribute{
+				Description: "The cron expressions drift detection runs on. Null if drift detection is disabled.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "The timezone of the schedule. Null if drift detection is disabled.",
+				Computed:    true,
+			},
+			"last_run_id": schema.StringAttribute{
+				Description: "The ID of the last drift detection run. Null if there is none.",
+				Computed:    true,
+			},
+			"last_run_state": schema.StringAttribute{
+				Description: "The state of the last drift detection run. Null if there is none.",
+				Computed:    true,
+			},
+			"last_run_at": schema.StringAttribute{
+				Description: "The timestamp the last drift detection run was created. Null if there is none.",
+				Computed:    true,
+			},
+			"drifted_resources": schema.Int64Attribute{
+				Description: "The number of resources drift detection found to have drifted.",
+				Computed:    true,
+			},
+			"drifted": schema.BoolAttribute{
+				Description: "Whether any resource of the stack has drifted.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *driftStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state driftStatusDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	driftDetection, err := d.client.GetDriftDetection(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Drift Detection",
+			"Could not read drift detection configuration: "+err.Error(),
+		)
+		return
+	}
+
+	// Stacks without drift detection have no drift detection runs to look for
+	var lastRun *Run
+	if driftDetection != nil {
+		runs, truncated, err := d.client.GetStackRuns(stackID, RunFilter{DriftDetection: true, Limit: 1})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading SpaceLift Stack Runs",
+				"Could not read drift detection runs: "+err.Error(),
+			)
+			return
+		}
+		if len(runs) > 0 {
+			lastRun = &runs[0]
+		}
+		if truncated {
+			resp.Diagnostics.AddWarning(
+				"SpaceLift Run History Truncated",
+				fmt.Sprintf("No drift detection run was found among the latest %d runs of stack '%s'; older runs were not searched.", runsMaxPages*runsPageSize, stackID),
+			)
+		}
+	}
+
+	drifted, err := d.client.CountDriftedResources(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Resources",
+			"Could not read drifted resources: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setDriftDetection(ctx, driftDetection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.setLastRun(lastRun)
+
+	state.ID = types.StringValue(stackID)
+	state.DriftedResources = types.Int64Value(int64(drifted))
+	state.Drifted = types.BoolValue(drifted > 0)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDriftStatusDataSourceMetadata tests the data source metadata.
+func TestDriftStatusDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &driftStatusDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_drift_status", resp.TypeName)
+}
+
+// TestDriftStatusDataSourceSchema tests the data source schema.
+func TestDriftStatusDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &driftStatusDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "enabled", "schedule", "last_run_id", "last_run_at", "drifted_resources", "drifted"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestDriftStatusModel tests setting drift detection and the last run on the model.
+func TestDriftStatusModel(t *testing.T) {
+	ctx := context.Background()
+	var model driftStatusDataSourceModel
+
+	require.False(t, model.setDriftDetection(ctx, nil).HasError())
+	assert.Equal(t, types.BoolValue(false), model.Enabled)
+	assert.True(t, model.Schedule.IsNull())
+
+	require.False(t, model.setDriftDetection(ctx, &DriftDetection{Reconcile: true, Schedule: []string{"0 * * * *"}, Timezone: "UTC"}).HasError())
+	assert.Equal(t, types.BoolValue(true), model.Enabled)
+	assert.Equal(t, types.BoolValue(true), model.Reconcile)
+	assert.Len(t, model.Schedule.Elements(), 1)
+
+	model.setLastRun(nil)
+	assert.True(t, model.LastRunID.IsNull())
+
+	model.setLastRun(&Run{ID: "run-1", State: "FINISHED", CreatedAt: 1767225600})
+	assert.Equal(t, types.StringValue("run-1"), model.LastRunID)
+	assert.Equal(t, types.StringValue("2026-01-01T00:00:00Z"), model.LastRunAt)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultMaxConcurrency is the number of stacks whose outputs are fetched at
+// the same time when max_concurrency is not configured.
+const defaultMaxConcurrency = 4
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &labeledStackOutputsDataSource{}
+	_ datasource.DataSourceWithConfigure = &labeledStackOutputsDataSource{}
+)
+
+// NewLabeledStackOutputsDataSource is a helper function to simplify the provider implementation.
+func NewLabeledStackOutputsDataSource() datasource.DataSource {
+	return &labeledStackOutputsDataSource{}
+}
+
+// labeledStackOutputsDataSource is the data source implementation.
+type labeledStackOutputsDataSource struct {
+	client *SpaceLiftClient
+}
+
+// labeledStackOutputsDataSourceModel maps the data source schema data.
+type labeledStackOutputsDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Labels         types.List   `tfsdk:"labels"`
+	Space          types.String `tfsdk:"space"`
+	MaxConcurrency types.Int64  `tfsdk:"max_concurrency"`
+	StackIDs       types.List   `tfsdk:"stack_ids"`
+	Outputs        types.Map    `tfsdk:"outputs"`
+	LastCheck      types.String `tfsdk:"last_check"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *labeledStackOutputsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *labeledStackOutputsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_labeled_stack_outputs"
+}
+
+// Schema defines the schema for the data source.
+func (d *labeledStackOutputsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the outputs of all SpaceLift stacks matching a set of labels.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "The labels a stack must carry, all of them, to be included.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"space": schema.StringAttribute{
+				Description: "The ID or path, such as \"root/prod/network\", of the space the stacks must belong to.",
+				Optional:    true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of stacks whose outputs are fetched at the same time. Defaults to %d.", defaultMaxConcurrency),
+				Optional:    true,
+			},
+			"stack_ids": schema.ListAttribute{
+				Description: "The IDs of the matching stacks, sorted.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"outputs": schema.MapAttribute{
+				Description: "The outputs of the matching stacks, keyed by stack ID and then by output name.",
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+			},
+			"last_check": schema.StringAttribute{
+				Description: "The timestamp of the last check.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *labeledStackOutputsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state labeledStackOutputsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels []string
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(labels) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("labels"),
+			"Missing Labels",
+			"At least one label must be set.",
+		)
+		return
+	}
+
+	maxConcurrency := defaultMaxConcurrency
+	if !state.MaxConcurrency.IsNull() {
+		maxConcurrency = int(state.MaxConcurrency.ValueInt64())
+		if maxConcurrency < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrency"),
+				"Invalid Max Concurrency",
+				fmt.Sprintf("The max_concurrency must be at least 1, got: %d", maxConcurrency),
+			)
+			return
+		}
+	}
+
+	// Find the matching stacks
+	stacks, err := d.client.FindStacksByLabels(labels, state.Space.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Searching SpaceLift Stacks",
+			"Could not search stacks: "+err.Error(),
+		)
+		return
+	}
+
+	stackIDs := make([]string, 0, len(stacks))
+	for _, stack := range stacks {
+		stackIDs = append(stackIDs, stack.ID)
+	}
+	sort.Strings(stackIDs)
+
+	// Get the outputs of every matching stack
+	stackOutputs, err := d.client.GetStacksOutputs(stackIDs, maxConcurrency)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Outputs",
+			"Could not read stack outputs: "+err.Error(),
+		)
+		return
+	}
+
+	outputs := make(map[string]map[string]string, len(stackOutputs))
+	for stackID, stackOutput := range stackOutputs {
+		outputs[stackID] = make(map[string]string, len(stackOutput))
+		for _, output := range stackOutput {
+			outputs[stackID][output.ID] = output.Value
+		}
+	}
+
+	stackIDsValue, diags := types.ListValueFrom(ctx, types.StringType, stackIDs)
+	resp.Diagnostics.Append(diags...)
+	outputsValue, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, outputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := strings.Join(labels, ",")
+	if !state.Space.IsNull() {
+		id = state.Space.ValueString() + ":" + id
+	}
+
+	state.ID = types.StringValue(id)
+	state.StackIDs = stackIDsValue
+	state.Outputs = outputsValue
+	state.LastCheck = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
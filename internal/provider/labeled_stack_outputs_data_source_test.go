@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLabeledStackOutputsDataSourceMetadata tests the data source metadata.
+func TestLabeledStackOutputsDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &labeledStackOutputsDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_labeled_stack_outputs", resp.TypeName)
+}
+
+// TestLabeledStackOutputsDataSourceSchema tests the data source schema.
+func TestLabeledStackOutputsDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &labeledStackOutputsDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"labels", "space", "max_concurrency", "stack_ids", "outputs", "last_check"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// clientLogSubsystem is the tflog subsystem used by SpaceLiftClient. Its level
+// can be set separately with the TF_LOG_PROVIDER_SPACELIFTOUTPUT_CLIENT
+// environment variable.
+const clientLogSubsystem = "client"
+
+// sensitiveLogFieldKeys are the log field keys whose values are always masked
+// in client logs, as they may hold credentials or stack output values.
+var sensitiveLogFieldKeys = []string{
+	"api_token",
+	"token",
+	"authorization",
+	"value",
+	"values",
+	"output",
+	"outputs",
+	"state",
+}
+
+// sensitiveLogPatterns match credentials that may appear anywhere in client
+// log messages or field values, including dumped response bodies.
+var sensitiveLogPatterns = []*regexp.Regexp{
+	// JSON Web Tokens, such as SpaceLift API tokens.
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+	// Bearer authorization header values.
+	regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._~+/=-]+`),
+}
+
+// newClientLogContext returns a context carrying the client log subsystem,
+// with masking of sensitive field values and credentials applied.
+func newClientLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, clientLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_SPACELIFTOUTPUT", clientLogSubsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, clientLogSubsystem, sensitiveLogFieldKeys...)
+	ctx = tflog.SubsystemMaskLogRegexes(ctx, clientLogSubsystem, sensitiveLogPatterns...)
+	return ctx
+}
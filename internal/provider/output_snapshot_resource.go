@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &outputSnapshotResource{}
+	_ resource.ResourceWithConfigure  = &outputSnapshotResource{}
+	_ resource.ResourceWithModifyPlan = &outputSnapshotResource{}
+)
+
+// NewOutputSnapshotResource is a helper function to simplify the provider implementation.
+func NewOutputSnapshotResource() resource.Resource {
+	return &outputSnapshotResource{}
+}
+
+// outputSnapshotResource is the resource implementation.
+type outputSnapshotResource struct {
+	client *SpaceLiftClient
+}
+
+// outputSnapshotResourceModel maps the resource schema data.
+type outputSnapshotResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	StackID         types.String `tfsdk:"stack_id"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	Refresh         types.String `tfsdk:"refresh"`
+	Outputs         types.Map    `tfsdk:"outputs"`
+	CapturedAt      types.String `tfsdk:"captured_at"`
+	UpstreamChanged types.Bool   `tfsdk:"upstream_changed"`
+}
+
+// snapshotNeedsRefresh reports whether the planned snapshot must capture the
+// stack outputs again, which is only the case when triggers or refresh change.
+func snapshotNeedsRefresh(state, plan outputSnapshotResourceModel) bool {
+	return !state.Triggers.Equal(plan.Triggers) || !state.Refresh.Equal(plan.Refresh)
+}
+
+// outputsMapValue converts stack outputs into a map value keyed by output name.
+func outputsMapValue(outputs []StackOutput) (types.Map, diag.Diagnostics) {
+	outputMap := make(map[string]attr.Value)
+	for _, output := range outputs {
+		outputMap[output.ID] = types.StringValue(output.Value)
+	}
+	return types.MapValue(types.StringType, outputMap)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *outputSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *outputSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output_snapshot"
+}
+
+// Schema defines the schema for the resource.
+func (r *outputSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Captures the outputs of a SpaceLift stack into Terraform state. The snapshot is only refreshed when triggers or refresh change, so upstream output changes do not ripple through consumers until they opt in. Outputs are always read as reported by the SpaceLift API: unlike the output data sources, the stack cannot be selected by stack_name and space, and outputs cannot be read from state or from an earlier run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack. Changing it replaces the snapshot. Selecting the stack by name is not supported.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that refresh the snapshot whenever they change.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"refresh": schema.StringAttribute{
+				Description: "Any value. Changing it refreshes the snapshot, for example a date or a ticket number.",
+				Optional:    true,
+			},
+			"outputs": schema.MapAttribute{
+				Description: "The outputs of the stack when the snapshot was captured.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"captured_at": schema.StringAttribute{
+				Description: "The timestamp the snapshot was captured.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"upstream_changed": schema.BoolAttribute{
+				Description: "Whether the current outputs of the stack differ from the snapshot, as of the last refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan marks the snapshot for refresh when triggers or refresh change.
+func (r *outputSnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when creating or destroying the snapshot
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan outputSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !snapshotNeedsRefresh(state, plan) {
+		return
+	}
+
+	plan.Outputs = types.MapUnknown(types.StringType)
+	plan.CapturedAt = types.StringUnknown()
+	plan.UpstreamChanged = types.BoolUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// capture sets the snapshot to the current outputs of the stack, as reported
+// by the SpaceLift API. Reading outputs from state is not supported because
+// the outputs attribute is not sensitive.
+func (r *outputSnapshotResource) capture(model *outputSnapshotResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stackID := model.StackID.ValueString()
+	outputs, err := r.client.GetStackOutputs(stackID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading SpaceLift Stack Outputs",
+			"Could not capture stack outputs: "+err.Error(),
+		)
+		return diags
+	}
+
+	outputsValue, d := outputsMapValue(outputs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ID = types.StringValue(stackID)
+	model.Outputs = outputsValue
+	model.CapturedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	model.UpstreamChanged = types.BoolValue(false)
+	return diags
+}
+
+// Create captures the outputs of the stack.
+func (r *outputSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan outputSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.capture(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read compares the snapshot with the current outputs of the stack, without
+// changing the snapshot itself.
+func (r *outputSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state outputSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Consumers keep working from the snapshot when the stack cannot be read
+	outputs, err := r.client.GetStackOutputs(state.StackID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check SpaceLift Stack Outputs",
+			"Could not compare the snapshot with the current stack outputs, so upstream_changed was not updated: "+err.Error(),
+		)
+		return
+	}
+
+	current, diags := outputsMapValue(outputs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.UpstreamChanged = types.BoolValue(!current.Equal(state.Outputs))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update captures the outputs of the stack again. Updates are only planned
+// when triggers or refresh change.
+func (r *outputSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan outputSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.capture(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the snapshot from state. Nothing is changed in SpaceLift.
+func (r *outputSnapshotResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
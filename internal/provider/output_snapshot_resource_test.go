@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOutputSnapshotResourceMetadata tests the resource metadata.
+func TestOutputSnapshotResourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	r := &outputSnapshotResource{}
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_output_snapshot", resp.TypeName)
+}
+
+// TestOutputSnapshotResourceSchema tests the resource schema.
+func TestOutputSnapshotResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	r := &outputSnapshotResource{}
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"id", "stack_id", "triggers", "refresh", "outputs", "captured_at", "upstream_changed"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+	assert.True(t, resp.Schema.Attributes["stack_id"].IsRequired())
+	assert.True(t, resp.Schema.Attributes["outputs"].IsComputed())
+	assert.False(t, resp.Schema.Attributes["outputs"].IsOptional())
+}
+
+// TestSnapshotNeedsRefresh tests that only triggers and refresh refresh the snapshot.
+func TestSnapshotNeedsRefresh(t *testing.T) {
+	triggers := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(value)})
+	}
+
+	state := outputSnapshotResourceModel{
+		StackID:  types.StringValue("network"),
+		Triggers: triggers("1"),
+		Refresh:  types.StringNull(),
+		Outputs:  types.MapValueMust(types.StringType, map[string]attr.Value{"vpc_id": types.StringValue("vpc-123")}),
+	}
+
+	plan := state
+	assert.False(t, snapshotNeedsRefresh(state, plan))
+
+	plan.UpstreamChanged = types.BoolValue(true)
+	assert.False(t, snapshotNeedsRefresh(state, plan))
+
+	plan = state
+	plan.Triggers = triggers("2")
+	assert.True(t, snapshotNeedsRefresh(state, plan))
+
+	plan = state
+	plan.Triggers = types.MapUnknown(types.StringType)
+	assert.True(t, snapshotNeedsRefresh(state, plan))
+
+	plan = state
+	plan.Refresh = types.StringValue("2026-10-19")
+	assert.True(t, snapshotNeedsRefresh(state, plan))
+}
+
+// TestOutputSnapshotResourceModifyPlan tests that the captured attributes are
+// only planned as unknown when the snapshot will be refreshed, including when
+// triggers are not known until apply.
+func TestOutputSnapshotResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &outputSnapshotResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	snapshot := outputSnapshotResourceModel{
+		ID:              types.StringValue("network"),
+		StackID:         types.StringValue("network"),
+		Triggers:        types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue("1")}),
+		Refresh:         types.StringNull(),
+		Outputs:         types.MapValueMust(types.StringType, map[string]attr.Value{"vpc_id": types.StringValue("vpc-123")}),
+		CapturedAt:      types.StringValue("2026-10-19T00:00:00Z"),
+		UpstreamChanged: types.BoolValue(false),
+	}
+
+	testCases := map[string]struct {
+		triggers    types.Map
+		wantRefresh bool
+	}{
+		"unchanged triggers": {triggers: snapshot.Triggers, wantRefresh: false},
+		"unknown triggers":   {triggers: types.MapUnknown(types.StringType), wantRefresh: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			require.False(t, state.Set(ctx, &snapshot).HasError())
+
+			planned := snapshot
+			planned.Triggers = tc.triggers
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+			require.False(t, plan.Set(ctx, &planned).HasError())
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError())
+
+			var got outputSnapshotResourceModel
+			require.False(t, resp.Plan.Get(ctx, &got).HasError())
+			assert.Equal(t, tc.wantRefresh, got.Outputs.IsUnknown())
+			assert.Equal(t, tc.wantRefresh, got.CapturedAt.IsUnknown())
+			assert.Equal(t, tc.wantRefresh, got.UpstreamChanged.IsUnknown())
+			assert.True(t, got.Triggers.Equal(tc.triggers))
+		})
+	}
+}
+
+// TestOutputsMapValue tests the conversion of stack outputs into a map.
+func TestOutputsMapValue(t *testing.T) {
+	value, diags := outputsMapValue([]StackOutput{
+		{ID: "vpc_id", Value: "vpc-123"},
+		{ID: "subnet_ids", Value: `["a","b"]`},
+	})
+	require.False(t, diags.HasError())
+	assert.Equal(t, map[string]attr.Value{
+		"vpc_id":     types.StringValue("vpc-123"),
+		"subnet_ids": types.StringValue(`["a","b"]`),
+	}, value.Elements())
+
+	empty, diags := outputsMapValue(nil)
+	require.False(t, diags.HasError())
+	assert.False(t, empty.IsNull())
+	assert.Empty(t, empty.Elements())
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// outputSourceOutputs reads outputs as reported by the SpaceLift API.
+	outputSourceOutputs = "outputs"
+	// outputSourceState reads outputs from the stack's SpaceLift-managed state.
+	outputSourceState = "state"
+)
+
+// outputOptions selects where, and as of which run, the outputs of a stack are read.
+type outputOptions struct {
+	Source    string
+	RunID     string
+	CommitSHA string
+}
+
+// newOutputOptions builds outputOptions from the output data source attributes.
+func newOutputOptions(source, runID, commitSHA types.String) outputOptions {
+	return outputOptions{
+		Source:    source.ValueString(),
+		RunID:     runID.ValueString(),
+		CommitSHA: commitSHA.ValueString(),
+	}
+}
+
+// historical reports whether outputs are read as of a past run rather than the latest one.
+func (o outputOptions) historical() bool {
+	return o.RunID != "" || o.CommitSHA != ""
+}
+
+// fromState reports whether outputs are read from managed state, which is
+// always the case for historical outputs.
+func (o outputOptions) fromState() bool {
+	return o.Source == outputSourceState || o.historical()
+}
+
+// validateOutputSource checks that the source attribute, if set, names a supported output source.
+func validateOutputSource(source types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if source.IsNull() || source.IsUnknown() {
+		return diags
+	}
+
+	switch source.ValueString() {
+	case outputSourceOutputs, outputSourceState:
+	default:
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Invalid Output Source",
+			fmt.Sprintf("The source attribute must be %q or %q, got: %q.", outputSourceOutputs, outputSourceState, source.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// validateOutputVersion checks that at most one of run_id and commit_sha is
+// set, and that historical outputs are not requested from the outputs source,
+// which only knows the latest outputs.
+func validateOutputVersion(source, runID, commitSHA types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !runID.IsNull() && !commitSHA.IsNull() {
+		diags.AddAttributeError(
+			path.Root("run_id"),
+			"Conflicting Output Version",
+			"At most one of run_id and commit_sha can be set.",
+		)
+	}
+
+	if !commitSHA.IsNull() && !commitSHA.IsUnknown() && commitSHA.ValueString() == "" {
+		diags.AddAttributeError(
+			path.Root("commit_sha"),
+			"Invalid Commit SHA",
+			"The commit_sha attribute must not be empty.",
+		)
+	}
+
+	if source.ValueString() == outputSourceOutputs && (!runID.IsNull() || !commitSHA.IsNull()) {
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Invalid Output Source",
+			fmt.Sprintf("Outputs as of a run or commit are read from state history, so source must be %q or unset when run_id or commit_sha is set.", outputSourceState),
+		)
+	}
+
+	return diags
+}
+
+// resolveOutputRun returns the ID of the finished tracked run whose outputs
+// were requested by run ID or commit SHA.
+func resolveOutputRun(client *SpaceLiftClient, stackID string, opts outputOptions) (string, error) {
+	if opts.RunID != "" {
+		run, err := client.GetStackRun(stackID, opts.RunID)
+		if err != nil {
+			return "", err
+		}
+		if run.Type != runTypeTracked || run.State != runStateFinished {
+			return "", fmt.Errorf("run %s is a %s run in state %s, but only %s %s runs record outputs", run.ID, run.Type, run.State, runStateFinished, runTypeTracked)
+		}
+		return run.ID, nil
+	}
+
+	runs, truncated, err := client.GetStackRuns(stackID, RunFilter{
+		Types:     []string{runTypeTracked},
+		States:    []string{runStateFinished},
+		CommitSHA: opts.CommitSHA,
+		Limit:     1,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(runs) == 0 && truncated {
+		return "", fmt.Errorf("no %s %s run found for commit %s among the latest %d runs of stack %s; use run_id for older runs", runStateFinished, runTypeTracked, opts.CommitSHA, runsMaxPages*runsPageSize, stackID)
+	}
+	if len(runs) == 0 {
+		return "", fmt.Errorf("no %s %s run found for commit %s in stack %s", runStateFinished, runTypeTracked, opts.CommitSHA, stackID)
+	}
+	return runs[0].ID, nil
+}
+
+// getOutputs retrieves the outputs of a stack as selected by opts, defaulting
+// to the latest outputs reported by the SpaceLift API. For historical outputs
+// it also returns the ID of the run they were read as of.
+func getOutputs(client *SpaceLiftClient, stackID string, opts outputOptions) ([]StackOutput, string, error) {
+	if opts.historical() {
+		runID, err := resolveOutputRun(client, stackID, opts)
+		if err != nil {
+			return nil, "", err
+		}
+
+		outputs, err := client.GetStackRunStateOutputs(stackID, runID)
+		return outputs, runID, err
+	}
+
+	if opts.Source == outputSourceState {
+		outputs, err := client.GetStackStateOutputs(stackID)
+		return outputs, "", err
+	}
+
+	outputs, err := client.GetStackOutputs(stackID)
+	return outputs, "", err
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOutputSource(t *testing.T) {
+	testCases := map[string]struct {
+		source    types.String
+		wantError bool
+	}{
+		"unset":   {source: types.StringNull()},
+		"unknown": {source: types.StringUnknown()},
+		"outputs": {source: types.StringValue(outputSourceOutputs)},
+		"state":   {source: types.StringValue(outputSourceState)},
+		"invalid": {source: types.StringValue("plan"), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateOutputSource(tc.source)
+			assert.Equal(t, tc.wantError, diags.HasError())
+		})
+	}
+}
+
+func TestValidateOutputVersion(t *testing.T) {
+	testCases := map[string]struct {
+		source    types.String
+		runID     types.String
+		commitSHA types.String
+		wantError bool
+	}{
+		"latest":                {source: types.StringNull(), runID: types.StringNull(), commitSHA: types.StringNull()},
+		"run_id":                {source: types.StringNull(), runID: types.StringValue("run-1"), commitSHA: types.StringNull()},
+		"commit_sha from state": {source: types.StringValue(outputSourceState), runID: types.StringNull(), commitSHA: types.StringValue("abc123")},
+		"both":                  {source: types.StringNull(), runID: types.StringValue("run-1"), commitSHA: types.StringValue("abc123"), wantError: true},
+		"empty commit_sha":      {source: types.StringNull(), runID: types.StringNull(), commitSHA: types.StringValue(""), wantError: true},
+		"run_id from outputs":   {source: types.StringValue(outputSourceOutputs), runID: types.StringValue("run-1"), commitSHA: types.StringNull(), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateOutputVersion(tc.source, tc.runID, tc.commitSHA)
+			assert.Equal(t, tc.wantError, diags.HasError())
+		})
+	}
+}
+
+func TestOutputOptions(t *testing.T) {
+	assert.False(t, outputOptions{}.fromState())
+	assert.True(t, outputOptions{Source: outputSourceState}.fromState())
+	assert.True(t, outputOptions{CommitSHA: "abc123"}.fromState())
+	assert.True(t, outputOptions{RunID: "run-1"}.historical())
+}
+
+// historyHandler serves a stack whose only run is a finished tracked run for
+// commit abc123, and a state download URL for that run.
+func historyHandler(t *testing.T, stateURL string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		run := map[string]interface{}{
+			"id":       "run-1",
+			"type":     runTypeTracked,
+			"state":    runStateFinished,
+			"commit":   map[string]interface{}{"hash": "abc123"},
+			"finished": true,
+		}
+
+		switch {
+		case strings.Contains(request.Query, "stateDownloadUrl"):
+			input := request.Variables["input"].(map[string]interface{})
+			assert.Equal(t, "run-1", input["runId"])
+			writeGraphQLData(t, w, map[string]interface{}{
+				"stateDownloadUrl": map[string]interface{}{"url": stateURL},
+			})
+		case strings.Contains(request.Query, "getStackRuns"):
+			writeGraphQLData(t, w, map[string]interface{}{
+				"stack": map[string]interface{}{"runs": []interface{}{run}},
+			})
+		default:
+			writeGraphQLData(t, w, map[string]interface{}{
+				"stack": map[string]interface{}{"run": run},
+			})
+		}
+	}
+}
+
+func TestGetOutputsHistorical(t *testing.T) {
+	stateServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testState))
+	}))
+	t.Cleanup(stateServer.Close)
+
+	client := newTestClient(t, historyHandler(t, stateServer.URL))
+
+	for name, opts := range map[string]outputOptions{
+		"run_id":     {RunID: "run-1"},
+		"commit_sha": {CommitSHA: "abc"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			outputs, runID, err := getOutputs(client, "network", opts)
+			require.NoError(t, err)
+			assert.Equal(t, "run-1", runID)
+			assert.Len(t, outputs, 3)
+		})
+	}
+
+	_, _, err := getOutputs(client, "network", outputOptions{CommitSHA: "def456"})
+	assert.EqualError(t, err, "no FINISHED TRACKED run found for commit def456 in stack network")
+}
+
+func TestResolveOutputRunRejectsUnfinishedRuns(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{
+				"run": map[string]interface{}{"id": "run-2", "type": "PROPOSED", "state": runStateFinished},
+			},
+		})
+	})
+
+	_, err := resolveOutputRun(client, "network", outputOptions{RunID: "run-2"})
+	assert.EqualError(t, err, "run run-2 is a PROPOSED run in state FINISHED, but only FINISHED TRACKED runs record outputs")
+}
+
+func TestResolveOutputRunReportsTruncatedHistory(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		runs := []map[string]interface{}{}
+		for i := 0; i < runsPageSize; i++ {
+			runs = append(runs, map[string]interface{}{"id": fmt.Sprintf("run-%d", i), "type": "PROPOSED", "state": runStateFinished})
+		}
+		writeGraphQLData(t, w, map[string]interface{}{
+			"stack": map[string]interface{}{"runs": runs},
+		})
+	})
+
+	_, err := resolveOutputRun(client, "network", outputOptions{CommitSHA: "def456"})
+	assert.EqualError(t, err, "no FINISHED TRACKED run found for commit def456 among the latest 1000 runs of stack network; use run_id for older runs")
+}
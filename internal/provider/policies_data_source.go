@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &policiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &policiesDataSource{}
+)
+
+// NewPoliciesDataSource is a helper function to simplify the provider implementation.
+func NewPoliciesDataSource() datasource.DataSource {
+	return &policiesDataSource{}
+}
+
+// policiesDataSource is the data source implementation.
+type policiesDataSource struct {
+	client *SpaceLiftClient
+}
+
+// policiesDataSourceModel maps the data source schema data.
+type policiesDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Types    types.List   `tfsdk:"types"`
+	Labels   types.List   `tfsdk:"labels"`
+	Space    types.String `tfsdk:"space"`
+	Policies types.List   `tfsdk:"policies"`
+}
+
+// policyModel maps a policy in the policies list.
+type policyModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	Labels            types.List   `tfsdk:"labels"`
+	Space             types.String `tfsdk:"space"`
+	AttachedStackIDs  types.List   `tfsdk:"attached_stack_ids"`
+	AttachedModuleIDs types.List   `tfsdk:"attached_module_ids"`
+}
+
+// policyAttrTypes are the attribute types of policyModel.
+var policyAttrTypes = map[string]attr.Type{
+	"id":                  types.StringType,
+	"name":                types.StringType,
+	"type":                types.StringType,
+	"labels":              types.ListType{ElemType: types.StringType},
+	"space":               types.StringType,
+	"attached_stack_ids":  types.ListType{ElemType: types.StringType},
+	"attached_module_ids": types.ListType{ElemType: types.StringType},
+}
+
+// newPolicyModel converts a policy into its Terraform model.
+func newPolicyModel(ctx context.Context, policy Policy) (policyModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Modules are attached like stacks, but are listed separately
+	stackIDs := []string{}
+	moduleIDs := []string{}
+	for _, attachment := range policy.AttachedStacks {
+		if attachment.IsModule {
+			moduleIDs = append(moduleIDs, attachment.StackID)
+			continue
+		}
+		stackIDs = append(stackIDs, attachment.StackID)
+	}
+
+	labels, d := types.ListValueFrom(ctx, types.StringType, policy.Labels)
+	diags.Append(d...)
+	attachedStackIDs, d := types.ListValueFrom(ctx, types.StringType, stackIDs)
+	diags.Append(d...)
+	attachedModuleIDs, d := types.ListValueFrom(ctx, types.StringType, moduleIDs)
+	diags.Append(d...)
+
+	return policyModel{
+		ID:                types.StringValue(policy.ID),
+		Name:              types.StringValue(policy.Name),
+		Type:              types.StringValue(policy.Type),
+		Labels:            labels,
+		Space:             types.StringValue(policy.Space),
+		AttachedStackIDs:  attachedStackIDs,
+		AttachedModuleIDs: attachedModuleIDs,
+	}, diags
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *policiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *policiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+// Schema defines the schema for the data source.
+func (d *policiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the SpaceLift policies the API token can see, along with the stacks they are attached to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"types": schema.ListAttribute{
+				Description: "Only return policies of one of these types, such as PLAN or APPROVAL.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"labels": schema.ListAttribute{
+				Description: "Only return policies carrying all of these labels.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"space": schema.StringAttribute{
+				Description: "Only return policies in this space, given as an ID or a path such as \"root/prod\".",
+				Optional:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "The matching policies, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the policy.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the policy, such as PLAN or APPROVAL.",
+							Computed:    true,
+						},
+						"labels": schema.ListAttribute{
+							Description: "The labels of the policy.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"space": schema.StringAttribute{
+							Description: "The ID of the space the policy belongs to.",
+							Computed:    true,
+						},
+						"attached_stack_ids": schema.ListAttribute{
+							Description: "The IDs of the stacks the policy is attached to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"attached_module_ids": schema.ListAttribute{
+							Description: "The IDs of the modules the policy is attached to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state policiesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := PolicyFilter{
+		Space: state.Space.ValueString(),
+	}
+	resp.Diagnostics.Append(state.Types.ElementsAs(ctx, &filter.Types, false)...)
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &filter.Labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.client.GetPolicies(filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Policies",
+			"Could not read policies: "+err.Error(),
+		)
+		return
+	}
+
+	models := []policyModel{}
+	for _, policy := range policies {
+		model, diags := newPolicyModel(ctx, policy)
+		resp.Diagnostics.Append(diags...)
+		models = append(models, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policiesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: policyAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("policies")
+	state.Policies = policiesValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPoliciesDataSourceMetadata tests the data source metadata.
+func TestPoliciesDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	for ds, want := range map[datasource.DataSource]string{
+		&policiesDataSource{}:      "spaceliftoutput_policies",
+		&stackPoliciesDataSource{}: "spaceliftoutput_stack_policies",
+	} {
+		resp := &datasource.MetadataResponse{}
+		ds.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "spaceliftoutput"}, resp)
+		assert.Equal(t, want, resp.TypeName)
+	}
+}
+
+// TestPoliciesDataSourceSchema tests the data source schemas.
+func TestPoliciesDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	resp := &datasource.SchemaResponse{}
+	(&policiesDataSource{}).Schema(ctx, datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"types", "labels", "space", "policies"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+
+	resp = &datasource.SchemaResponse{}
+	(&stackPoliciesDataSource{}).Schema(ctx, datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "policies", "policy_ids"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestNewPolicyModel tests the conversion of policies into their Terraform model.
+func TestNewPolicyModel(t *testing.T) {
+	ctx := context.Background()
+	policy := Policy{
+		ID:   "require-approval",
+		Name: "Require approval",
+		Type: "APPROVAL",
+		AttachedStacks: []PolicyAttachment{
+			{ID: "a1", StackID: "vpc-prod"},
+			{ID: "a2", StackID: "vpc-dev"},
+			{ID: "a3", StackID: "terraform-aws-vpc", IsModule: true},
+		},
+	}
+
+	model, diags := newPolicyModel(ctx, policy)
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("APPROVAL"), model.Type)
+
+	var stackIDs []string
+	require.False(t, model.AttachedStackIDs.ElementsAs(ctx, &stackIDs, false).HasError())
+	assert.Equal(t, []string{"vpc-prod", "vpc-dev"}, stackIDs)
+
+	var moduleIDs []string
+	require.False(t, model.AttachedModuleIDs.ElementsAs(ctx, &moduleIDs, false).HasError())
+	assert.Equal(t, []string{"terraform-aws-vpc"}, moduleIDs)
+}
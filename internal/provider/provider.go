@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -15,7 +19,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &SpaceLiftOutputProvider{}
+	_ provider.Provider                       = &SpaceLiftOutputProvider{}
+	_ provider.ProviderWithEphemeralResources = &SpaceLiftOutputProvider{}
 )
 
 // SpaceLiftOutputProvider is the provider implementation.
@@ -29,9 +34,13 @@ type SpaceLiftOutputProvider struct {
 
 // SpaceLiftOutputProviderModel describes the provider data model.
 type SpaceLiftOutputProviderModel struct {
-	ApiToken    types.String `tfsdk:"api_token"`
-	ApiUrl      types.String `tfsdk:"api_url"`
-	AccountName types.String `tfsdk:"account_name"`
+	ApiToken            types.String `tfsdk:"api_token"`
+	ApiTokenFile        types.String `tfsdk:"api_token_file"`
+	ApiUrl              types.String `tfsdk:"api_url"`
+	AccountName         types.String `tfsdk:"account_name"`
+	ValidateCredentials types.Bool   `tfsdk:"validate_credentials"`
+	TokenExpiryWindow   types.String `tfsdk:"token_expiry_window"`
+	DebugDumpResponses  types.Bool   `tfsdk:"debug_dump_responses"`
 }
 
 // ProviderOption is a function that configures a provider.
@@ -44,8 +53,9 @@ func New(version string, opts ...ProviderOption) func() provider.Provider {
 			version: version,
 			CreateClient: func(ctx context.Context, apiToken, apiUrl string) (*SpaceLiftClient, error) {
 				return &SpaceLiftClient{
-					ApiToken: apiToken,
-					ApiUrl:   apiUrl,
+					ApiToken:   apiToken,
+					ApiUrl:     apiUrl,
+					HTTPClient: &http.Client{Timeout: defaultHTTPTimeout},
 				}, nil
 			},
 		}
@@ -75,6 +85,11 @@ func (p *SpaceLiftOutputProvider) Schema(_ context.Context, _ provider.SchemaReq
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_token_file": schema.StringAttribute{
+				Description: "Path to a file containing the SpaceLift API token. The file is re-read when it changes or when the API rejects the token, " +
+					"so it can be rotated while Terraform is running. Conflicts with api_token. Can also be set with the SPACELIFT_API_TOKEN_FILE environment variable.",
+				Optional: true,
+			},
 			"api_url": schema.StringAttribute{
 				Description: "The SpaceLift API URL. If not specified, it will be constructed using the account_name.",
 				Optional:    true,
@@ -83,6 +98,18 @@ func (p *SpaceLiftOutputProvider) Schema(_ context.Context, _ provider.SchemaReq
 				Description: "Your account name in Spacelift. Used to construct the API URL if api_url is not specified. Can also be set with the TF_VAR_spacelift_account_name or spacelift_account_name environment variables.",
 				Optional:    true,
 			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "Whether to check the API token against the SpaceLift API when the provider is configured, so that invalid credentials fail fast. Defaults to false.",
+				Optional:    true,
+			},
+			"token_expiry_window": schema.StringAttribute{
+				Description: "How long the API token must remain valid for, as a Go duration such as \"90m\". A warning is emitted when the token expires within this window. Defaults to \"1h\".",
+				Optional:    true,
+			},
+			"debug_dump_responses": schema.BoolAttribute{
+				Description: "Whether to write raw SpaceLift API response bodies to the TRACE log. Response bodies can contain output values, so only enable this while debugging. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -112,6 +139,35 @@ func (p *SpaceLiftOutputProvider) Configure(ctx context.Context, req provider.Co
 		)
 	}
 
+	if config.ApiTokenFile.IsUnknown() {
+		tflog.Error(ctx, "Unknown SpaceLift API Token File configuration")
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token_file"),
+			"Unknown SpaceLift API Token File",
+			"The provider cannot create the SpaceLift API client as there is an unknown configuration value for the SpaceLift API token file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SPACELIFT_API_TOKEN_FILE environment variable.",
+		)
+	}
+
+	if !config.ApiToken.IsNull() && !config.ApiTokenFile.IsNull() {
+		tflog.Error(ctx, "Conflicting SpaceLift API Token configuration")
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token_file"),
+			"Conflicting SpaceLift API Token Configuration",
+			"Only one of api_token and api_token_file can be set in the provider configuration.",
+		)
+	}
+
+	if config.TokenExpiryWindow.IsUnknown() {
+		tflog.Error(ctx, "Unknown Token Expiry Window configuration")
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_expiry_window"),
+			"Unknown Token Expiry Window",
+			"The provider cannot create the SpaceLift API client as there is an unknown configuration value for the token expiry window. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the default value.",
+		)
+	}
+
 	if config.ApiUrl.IsUnknown() {
 		tflog.Error(ctx, "Unknown SpaceLift API URL configuration")
 		resp.Diagnostics.AddAttributeError(
@@ -139,6 +195,7 @@ func (p *SpaceLiftOutputProvider) Configure(ctx context.Context, req provider.Co
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 	apiToken := os.Getenv("SPACELIFT_API_TOKEN")
+	apiTokenFile := os.Getenv("SPACELIFT_API_TOKEN_FILE")
 	var apiUrl string
 
 	// First check TF_VAR_spacelift_account_name, then fallback to spacelift_account_name
@@ -162,11 +219,37 @@ func (p *SpaceLiftOutputProvider) Configure(ctx context.Context, req provider.Co
 	// Override with configuration values if provided
 	if !config.ApiToken.IsNull() {
 		apiToken = config.ApiToken.ValueString()
+		apiTokenFile = ""
 		tflog.Debug(ctx, "Using API token from configuration")
+	} else if !config.ApiTokenFile.IsNull() {
+		apiTokenFile = config.ApiTokenFile.ValueString()
+		tflog.Debug(ctx, "Using API token file from configuration", map[string]interface{}{
+			"api_token_file": apiTokenFile,
+		})
 	} else {
 		tflog.Debug(ctx, "Using API token from environment")
 	}
 
+	// A token file takes precedence over a static token, so that rotated
+	// tokens are picked up while Terraform is running.
+	if apiTokenFile != "" {
+		token, err := readTokenFile(apiTokenFile)
+		if err != nil {
+			tflog.Error(ctx, "Failed to read SpaceLift API Token File", map[string]interface{}{
+				"error": err.Error(),
+			})
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_token_file"),
+				"Unable to Read SpaceLift API Token File",
+				"The provider cannot create the SpaceLift API client as the SpaceLift API token file could not be read. "+
+					"Ensure the file set in api_token_file or SPACELIFT_API_TOKEN_FILE exists and contains a token.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		apiToken = token
+	}
+
 	if !config.AccountName.IsNull() {
 		accountName = config.AccountName.ValueString()
 		tflog.Debug(ctx, "Using account name from configuration", map[string]interface{}{
@@ -195,17 +278,55 @@ func (p *SpaceLiftOutputProvider) Configure(ctx context.Context, req provider.Co
 			path.Root("api_token"),
 			"Missing SpaceLift API Token",
 			"The provider cannot create the SpaceLift API client as there is a missing or empty value for the SpaceLift API token. "+
-				"Set the api_token value in the configuration or use the SPACELIFT_API_TOKEN environment variable. "+
+				"Set the api_token or api_token_file value in the configuration or use the SPACELIFT_API_TOKEN or SPACELIFT_API_TOKEN_FILE environment variables. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	} else {
 		tflog.Debug(ctx, "SpaceLift API Token is set")
 	}
 
+	expiryWindow := defaultTokenExpiryWindow
+	if !config.TokenExpiryWindow.IsNull() {
+		window, err := time.ParseDuration(config.TokenExpiryWindow.ValueString())
+		if err != nil || window < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_expiry_window"),
+				"Invalid Token Expiry Window",
+				"The token_expiry_window value must be a non-negative Go duration such as \"90m\" or \"2h\", got: "+config.TokenExpiryWindow.ValueString(),
+			)
+		}
+		expiryWindow = window
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	tokenAttribute := path.Root("api_token")
+	if apiTokenFile != "" {
+		tokenAttribute = path.Root("api_token_file")
+	}
+
+	claims, err := parseTokenClaims(apiToken)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to decode SpaceLift API token claims, skipping expiry check", map[string]interface{}{
+			"error": err.Error(),
+		})
+	} else {
+		fields := map[string]interface{}{
+			"subject": claims.Subject,
+			"expiry":  "no expiry",
+		}
+		if expiry := claims.Expiry(); !expiry.IsZero() {
+			fields["expiry"] = expiry.Format(time.RFC3339)
+		}
+		tflog.Debug(ctx, "Decoded SpaceLift API token claims", fields)
+		resp.Diagnostics.Append(tokenExpiryDiagnostics(claims, time.Now(), expiryWindow, tokenAttribute)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Debug(ctx, "Creating SpaceLift client")
 
 	// Create a new SpaceLift client using the configuration values
@@ -223,15 +344,60 @@ func (p *SpaceLiftOutputProvider) Configure(ctx context.Context, req provider.Co
 		return
 	}
 
-	// Set the context in the client for logging
-	client.ctx = ctx
+	// Set the context in the client for logging. Client logs go to their own
+	// subsystem, with tokens and output values masked.
+	client.ctx = newClientLogContext(ctx)
+	client.ApiTokenFile = apiTokenFile
+	client.DebugDump = config.DebugDumpResponses.ValueBool()
+
+	if config.ValidateCredentials.ValueBool() {
+		p.validateCredentials(ctx, client, tokenAttribute, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	tflog.Debug(ctx, "Successfully configured SpaceLift provider")
 
-	// Make the SpaceLift client available during DataSource and Resource
-	// type Configure methods.
+	// Make the SpaceLift client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// validateCredentials checks the client's API token against the SpaceLift API,
+// adding a diagnostic on tokenAttribute if the token is rejected.
+func (p *SpaceLiftOutputProvider) validateCredentials(ctx context.Context, client *SpaceLiftClient, tokenAttribute path.Path, resp *provider.ConfigureResponse) {
+	tflog.Debug(ctx, "Validating SpaceLift credentials")
+
+	viewer, err := client.GetViewer()
+	if errors.Is(err, errUnauthorized) {
+		tflog.Error(ctx, "SpaceLift API rejected the API token")
+		resp.Diagnostics.AddAttributeError(
+			tokenAttribute,
+			"Invalid SpaceLift API Token",
+			"The SpaceLift API rejected the configured API token. "+
+				"Ensure the token is valid, has not expired and belongs to the account at "+client.ApiUrl+".",
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to validate SpaceLift credentials", map[string]interface{}{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Unable to Validate SpaceLift Credentials",
+			"An unexpected error occurred when validating the SpaceLift API token.\n\n"+
+				"SpaceLift Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Validated SpaceLift credentials", map[string]interface{}{
+		"viewer_id":   viewer.ID,
+		"viewer_name": viewer.Name,
+	})
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -239,10 +405,37 @@ func (p *SpaceLiftOutputProvider) DataSources(_ context.Context) []func() dataso
 	return []func() datasource.DataSource{
 		NewStackOutputsDataSource,
 		NewStackOutputDataSource,
+		NewViewerDataSource,
+		NewContextDataSource,
+		NewStackDependenciesDataSource,
+		NewRunsDataSource,
+		NewRunDataSource,
+		NewSpaceDataSource,
+		NewSpacesDataSource,
+		NewLabeledStackOutputsDataSource,
+		NewStackResourcesDataSource,
+		NewWorkerPoolDataSource,
+		NewWorkerPoolsDataSource,
+		NewStackEnvironmentDataSource,
+		NewStackDependentsDataSource,
+		NewDriftStatusDataSource,
+		NewCurrentStackDataSource,
+		NewPoliciesDataSource,
+		NewStackPoliciesDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *SpaceLiftOutputProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewOutputSnapshotResource,
+		NewStackRunResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *SpaceLiftOutputProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewStackOutputEphemeralResource,
+	}
 }
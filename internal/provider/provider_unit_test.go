@@ -44,6 +44,10 @@ func TestProviderSchema(t *testing.T) {
 		t.Errorf("Expected provider schema to have 'api_token' attribute")
 	}
 
+	if _, ok := schemaResp.Schema.Attributes["api_token_file"]; !ok {
+		t.Errorf("Expected provider schema to have 'api_token_file' attribute")
+	}
+
 	if _, ok := schemaResp.Schema.Attributes["api_url"]; !ok {
 		t.Errorf("Expected provider schema to have 'api_url' attribute")
 	}
@@ -51,6 +55,18 @@ func TestProviderSchema(t *testing.T) {
 	if _, ok := schemaResp.Schema.Attributes["account_name"]; !ok {
 		t.Errorf("Expected provider schema to have 'account_name' attribute")
 	}
+
+	if _, ok := schemaResp.Schema.Attributes["validate_credentials"]; !ok {
+		t.Errorf("Expected provider schema to have 'validate_credentials' attribute")
+	}
+
+	if _, ok := schemaResp.Schema.Attributes["token_expiry_window"]; !ok {
+		t.Errorf("Expected provider schema to have 'token_expiry_window' attribute")
+	}
+
+	if _, ok := schemaResp.Schema.Attributes["debug_dump_responses"]; !ok {
+		t.Errorf("Expected provider schema to have 'debug_dump_responses' attribute")
+	}
 }
 
 // TestProviderDataSources tests the provider data sources.
@@ -62,8 +78,8 @@ func TestProviderDataSources(t *testing.T) {
 
 	dataSources := p.DataSources(ctx)
 
-	if len(dataSources) != 2 {
-		t.Errorf("Expected provider to have 2 data sources, got %d", len(dataSources))
+	if len(dataSources) != 19 {
+		t.Errorf("Expected provider to have 19 data sources, got %d", len(dataSources))
 	}
 }
 
@@ -76,8 +92,22 @@ func TestProviderResources(t *testing.T) {
 
 	resources := p.Resources(ctx)
 
-	if len(resources) != 0 {
-		t.Errorf("Expected provider to have 0 resources, got %d", len(resources))
+	if len(resources) != 2 {
+		t.Errorf("Expected provider to have 2 resources, got %d", len(resources))
+	}
+}
+
+// TestProviderEphemeralResources tests the provider ephemeral resources.
+func TestProviderEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+	p := &SpaceLiftOutputProvider{
+		version: "test",
+	}
+
+	ephemeralResources := p.EphemeralResources(ctx)
+
+	if len(ephemeralResources) != 1 {
+		t.Errorf("Expected provider to have 1 ephemeral resource, got %d", len(ephemeralResources))
 	}
 }
 
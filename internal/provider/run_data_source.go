@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runTypeTracked is the type of runs that apply changes.
+const runTypeTracked = "TRACKED"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &runDataSource{}
+	_ datasource.DataSourceWithConfigure = &runDataSource{}
+)
+
+// NewRunDataSource is a helper function to simplify the provider implementation.
+func NewRunDataSource() datasource.DataSource {
+	return &runDataSource{}
+}
+
+// runDataSource is the data source implementation.
+type runDataSource struct {
+	client *SpaceLiftClient
+}
+
+// runDataSourceModel maps the data source schema data.
+type runDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	StackID          types.String `tfsdk:"stack_id"`
+	RunID            types.String `tfsdk:"run_id"`
+	Type             types.String `tfsdk:"type"`
+	Tracked          types.Bool   `tfsdk:"tracked"`
+	State            types.String `tfsdk:"state"`
+	Finished         types.Bool   `tfsdk:"finished"`
+	Branch           types.String `tfsdk:"branch"`
+	CommitSHA        types.String `tfsdk:"commit_sha"`
+	CommitMessage    types.String `tfsdk:"commit_message"`
+	TriggeredBy      types.String `tfsdk:"triggered_by"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	FinishedAt       types.String `tfsdk:"finished_at"`
+	ResourcesAdded   types.Int64  `tfsdk:"resources_added"`
+	ResourcesChanged types.Int64  `tfsdk:"resources_changed"`
+	ResourcesDeleted types.Int64  `tfsdk:"resources_deleted"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *runDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *runDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run"
+}
+
+// Schema defines the schema for the data source.
+func (d *runDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the details of a single SpaceLift run, including its change summary.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack.",
+				Required:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "The ID of the run.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the run, such as TRACKED or PROPOSED.",
+				Computed:    true,
+			},
+			"tracked": schema.BoolAttribute{
+				Description: "Whether the run is a tracked run, which applies changes, rather than a proposed run.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the run, such as FINISHED or FAILED.",
+				Computed:    true,
+			},
+			"finished": schema.BoolAttribute{
+				Description: "Whether the run has reached a final state.",
+				Computed:    true,
+			},
+			"branch": schema.StringAttribute{
+				Description: "The branch the run was triggered for.",
+				Computed:    true,
+			},
+			"commit_sha": schema.StringAttribute{
+				Description: "The SHA of the commit the run was triggered for.",
+				Computed:    true,
+			},
+			"commit_message": schema.StringAttribute{
+				Description: "The message of the commit the run was triggered for.",
+				Computed:    true,
+			},
+			"triggered_by": schema.StringAttribute{
+				Description: "Who or what triggered the run. Null for runs triggered by a push.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp the run was created.",
+				Computed:    true,
+			},
+			"finished_at": schema.StringAttribute{
+				Description: "The timestamp the run finished. Null while the run is in progress.",
+				Computed:    true,
+			},
+			"resources_added": schema.Int64Attribute{
+				Description: "The number of resources the run added. Null if the run has not planned yet.",
+				Computed:    true,
+			},
+			"resources_changed": schema.Int64Attribute{
+				Description: "The number of resources the run changed. Null if the run has not planned yet.",
+				Computed:    true,
+			},
+			"resources_deleted": schema.Int64Attribute{
+				Description: "The number of resources the run deleted. Null if the run has not planned yet.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *runDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state runDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	runID := state.RunID.ValueString()
+	run, err := d.client.GetStackRun(stackID, runID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Run",
+			"Could not read run: "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(stackID + ":" + runID)
+	state.setRun(run)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// setRun updates the model with the details of the run.
+func (m *runDataSourceModel) setRun(run *Run) {
+	summary := newRunModel(*run)
+
+	m.Type = summary.Type
+	m.Tracked = types.BoolValue(run.Type == runTypeTracked)
+	m.State = summary.State
+	m.Finished = types.BoolValue(run.Finished)
+	m.Branch = summary.Branch
+	m.CommitSHA = summary.CommitSHA
+	m.CommitMessage = summary.CommitMessage
+	m.TriggeredBy = summary.TriggeredBy
+	m.CreatedAt = summary.CreatedAt
+	m.FinishedAt = summary.FinishedAt
+
+	m.ResourcesAdded = types.Int64Null()
+	m.ResourcesChanged = types.Int64Null()
+	m.ResourcesDeleted = types.Int64Null()
+	if run.Delta != nil {
+		m.ResourcesAdded = types.Int64Value(run.Delta.Added)
+		m.ResourcesChanged = types.Int64Value(run.Delta.Changed)
+		m.ResourcesDeleted = types.Int64Value(run.Delta.Deleted)
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRunDataSourceMetadata tests the data source metadata.
+func TestRunDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &runDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_run", resp.TypeName)
+}
+
+// TestRunDataSourceSchema tests the data source schema.
+func TestRunDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &runDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "run_id", "tracked", "state", "resources_added", "resources_changed", "resources_deleted"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestRunDataSourceModelSetRun tests that the change summary is only set once the run has a delta.
+func TestRunDataSourceModelSetRun(t *testing.T) {
+	var model runDataSourceModel
+
+	model.setRun(&Run{ID: "run-1", Type: "PROPOSED", State: "QUEUED"})
+	assert.Equal(t, types.BoolValue(false), model.Tracked)
+	assert.True(t, model.ResourcesDeleted.IsNull())
+
+	model.setRun(&Run{ID: "run-2", Type: runTypeTracked, State: "FINISHED", Finished: true, Delta: &RunDelta{Added: 3}})
+	assert.Equal(t, types.BoolValue(true), model.Tracked)
+	assert.Equal(t, types.Int64Value(3), model.ResourcesAdded)
+	assert.Equal(t, types.Int64Value(0), model.ResourcesDeleted)
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultRunsLimit is the number of runs returned when no limit is configured.
+const defaultRunsLimit = 10
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &runsDataSource{}
+	_ datasource.DataSourceWithConfigure = &runsDataSource{}
+)
+
+// NewRunsDataSource is a helper function to simplify the provider implementation.
+func NewRunsDataSource() datasource.DataSource {
+	return &runsDataSource{}
+}
+
+// runsDataSource is the data source implementation.
+type runsDataSource struct {
+	client *SpaceLiftClient
+}
+
+// runsDataSourceModel maps the data source schema data.
+type runsDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	StackID types.String `tfsdk:"stack_id"`
+	States  types.List   `tfsdk:"states"`
+	Types   types.List   `tfsdk:"types"`
+	Branch  types.String `tfsdk:"branch"`
+	Limit   types.Int64  `tfsdk:"limit"`
+	Runs    types.List   `tfsdk:"runs"`
+}
+
+// runModel maps a run in the runs list.
+type runModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	State         types.String `tfsdk:"state"`
+	Branch        types.String `tfsdk:"branch"`
+	CommitSHA     types.String `tfsdk:"commit_sha"`
+	CommitMessage types.String `tfsdk:"commit_message"`
+	TriggeredBy   types.String `tfsdk:"triggered_by"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	FinishedAt    types.String `tfsdk:"finished_at"`
+}
+
+// runAttrTypes are the attribute types of runModel.
+var runAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"type":           types.StringType,
+	"state":          types.StringType,
+	"branch":         types.StringType,
+	"commit_sha":     types.StringType,
+	"commit_message": types.StringType,
+	"triggered_by":   types.StringType,
+	"created_at":     types.StringType,
+	"finished_at":    types.StringType,
+}
+
+// newRunModel converts a run into its Terraform model.
+func newRunModel(run Run) runModel {
+	finishedAt := types.StringNull()
+	if finished := run.FinishedTime(); !finished.IsZero() {
+		finishedAt = types.StringValue(finished.Format(time.RFC3339))
+	}
+
+	return runModel{
+		ID:            types.StringValue(run.ID),
+		Type:          types.StringValue(run.Type),
+		State:         types.StringValue(run.State),
+		Branch:        types.StringValue(run.Branch),
+		CommitSHA:     types.StringValue(run.Commit.Hash),
+		CommitMessage: types.StringValue(run.Commit.Message),
+		TriggeredBy:   types.StringPointerValue(run.TriggeredBy),
+		CreatedAt:     types.StringValue(run.CreatedTime().Format(time.RFC3339)),
+		FinishedAt:    finishedAt,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *runsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *runsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runs"
+}
+
+// Schema defines the schema for the data source.
+func (d *runsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Retrieves the recent runs of a SpaceLift stack, newest first. Only the latest %d runs of the stack are searched.", runsMaxPages*runsPageSize),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack.",
+				Required:    true,
+			},
+			"states": schema.ListAttribute{
+				Description: "Only return runs in one of these states, such as FINISHED or FAILED.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"types": schema.ListAttribute{
+				Description: "Only return runs of one of these types, such as TRACKED or PROPOSED.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"branch": schema.StringAttribute{
+				Description: "Only return runs for this branch.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of runs to return. Defaults to %d. Fewer runs are returned, with a warning, when not enough runs match among the latest %d runs of the stack.", defaultRunsLimit, runsMaxPages*runsPageSize),
+				Optional:    true,
+			},
+			"runs": schema.ListNestedAttribute{
+				Description: "The matching runs, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the run.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the run, such as TRACKED or PROPOSED.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the run, such as FINISHED or FAILED.",
+							Computed:    true,
+						},
+						"branch": schema.StringAttribute{
+							Description: "The branch the run was triggered for.",
+							Computed:    true,
+						},
+						"commit_sha": schema.StringAttribute{
+							Description: "The SHA of the commit the run was triggered for.",
+							Computed:    true,
+						},
+						"commit_message": schema.StringAttribute{
+							Description: "The message of the commit the run was triggered for.",
+							Computed:    true,
+						},
+						"triggered_by": schema.StringAttribute{
+							Description: "Who or what triggered the run. Null for runs triggered by a push.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp the run was created.",
+							Computed:    true,
+						},
+						"finished_at": schema.StringAttribute{
+							Description: "The timestamp the run finished. Null while the run is in progress.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *runsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state runsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := RunFilter{
+		Branch: state.Branch.ValueString(),
+		Limit:  defaultRunsLimit,
+	}
+	resp.Diagnostics.Append(state.States.ElementsAs(ctx, &filter.States, false)...)
+	resp.Diagnostics.Append(state.Types.ElementsAs(ctx, &filter.Types, false)...)
+	if !state.Limit.IsNull() {
+		filter.Limit = int(state.Limit.ValueInt64())
+		if filter.Limit < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limit"),
+				"Invalid Limit",
+				fmt.Sprintf("The limit must be at least 1, got: %d", filter.Limit),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	runs, truncated, err := d.client.GetStackRuns(stackID, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Runs",
+			"Could not read stack runs: "+err.Error(),
+		)
+		return
+	}
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"SpaceLift Run History Truncated",
+			fmt.Sprintf("Only %d of the requested %d runs of stack '%s' were found among its latest %d runs; older runs were not searched.", len(runs), filter.Limit, stackID, runsMaxPages*runsPageSize),
+		)
+	}
+
+	models := []runModel{}
+	for _, run := range runs {
+		models = append(models, newRunModel(run))
+	}
+
+	runsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: runAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(stackID)
+	state.Runs = runsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRunsDataSourceMetadata tests the data source metadata.
+func TestRunsDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &runsDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_runs", resp.TypeName)
+}
+
+// TestRunsDataSourceSchema tests the data source schema.
+func TestRunsDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &runsDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "states", "types", "branch", "limit", "runs"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestNewRunModel tests the conversion of runs into their Terraform model.
+func TestNewRunModel(t *testing.T) {
+	triggeredBy := "api::ci-key"
+	run := Run{
+		ID:          "run-1",
+		Type:        "TRACKED",
+		State:       "APPLYING",
+		Branch:      "main",
+		Commit:      RunCommit{Hash: "abc123", Message: "Add subnets"},
+		TriggeredBy: &triggeredBy,
+		CreatedAt:   1767225600,
+	}
+
+	model := newRunModel(run)
+	assert.Equal(t, types.StringValue("abc123"), model.CommitSHA)
+	assert.Equal(t, types.StringValue("api::ci-key"), model.TriggeredBy)
+	assert.Equal(t, types.StringValue("2026-01-01T00:00:00Z"), model.CreatedAt)
+	assert.True(t, model.FinishedAt.IsNull())
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &spaceDataSource{}
+	_ datasource.DataSourceWithConfigure      = &spaceDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &spaceDataSource{}
+)
+
+// NewSpaceDataSource is a helper function to simplify the provider implementation.
+func NewSpaceDataSource() datasource.DataSource {
+	return &spaceDataSource{}
+}
+
+// spaceDataSource is the data source implementation.
+type spaceDataSource struct {
+	client *SpaceLiftClient
+}
+
+// spaceDataSourceModel maps the data source schema data.
+type spaceDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SpaceID         types.String `tfsdk:"space_id"`
+	Path            types.String `tfsdk:"path"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	ParentSpaceID   types.String `tfsdk:"parent_space_id"`
+	Labels          types.List   `tfsdk:"labels"`
+	InheritEntities types.Bool   `tfsdk:"inherit_entities"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *spaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *spaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+// Schema defines the schema for the data source.
+func (d *spaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a SpaceLift space by ID or by path.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"space_id": schema.StringAttribute{
+				Description: "The ID of the space. Exactly one of space_id and path must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "The path of the space, made of space names separated by slashes and starting at the topmost space the API token can see, such as \"root/prod/network\". Exactly one of space_id and path must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the space.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the space.",
+				Computed:    true,
+			},
+			"parent_space_id": schema.StringAttribute{
+				Description: "The ID of the parent space. Null for the root space.",
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "The labels of the space.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"inherit_entities": schema.BoolAttribute{
+				Description: "Whether the space inherits entities, such as contexts and policies, from its parent space.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that exactly one of space_id and path is set.
+func (d *spaceDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config spaceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SpaceID.IsUnknown() || config.Path.IsUnknown() {
+		return
+	}
+
+	if config.SpaceID.IsNull() == config.Path.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("space_id"),
+			"Invalid Space Selector",
+			"Exactly one of space_id and path must be set.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state spaceDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaces, err := d.client.GetSpaces()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Spaces",
+			"Could not read spaces: "+err.Error(),
+		)
+		return
+	}
+
+	tree := newSpaceTree(spaces)
+
+	var space *Space
+	if !state.SpaceID.IsNull() {
+		found, ok := tree[state.SpaceID.ValueString()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("space_id"),
+				"Space Not Found",
+				fmt.Sprintf("Space with ID '%s' not found", state.SpaceID.ValueString()),
+			)
+			return
+		}
+		space = &found
+	} else {
+		space, err = tree.FindByPath(state.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("path"),
+				"Space Not Found",
+				"Could not find space: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	model, diags := newSpaceModel(ctx, tree, *space)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = model.ID
+	state.SpaceID = model.ID
+	state.Path = model.Path
+	state.Name = model.Name
+	state.Description = model.Description
+	state.ParentSpaceID = model.ParentSpaceID
+	state.Labels = model.Labels
+	state.InheritEntities = model.InheritEntities
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpaceDataSourceMetadata tests the data source metadata.
+func TestSpaceDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	for ds, want := range map[datasource.DataSource]string{
+		&spaceDataSource{}:  "spaceliftoutput_space",
+		&spacesDataSource{}: "spaceliftoutput_spaces",
+	} {
+		resp := &datasource.MetadataResponse{}
+		ds.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "spaceliftoutput"}, resp)
+		assert.Equal(t, want, resp.TypeName)
+	}
+}
+
+// TestSpaceDataSourceSchema tests the data source schemas.
+func TestSpaceDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	resp := &datasource.SchemaResponse{}
+	(&spaceDataSource{}).Schema(ctx, datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"space_id", "path", "name", "parent_space_id", "labels", "inherit_entities"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+
+	resp = &datasource.SchemaResponse{}
+	(&spacesDataSource{}).Schema(ctx, datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, resp.Schema.Attributes["spaces"])
+}
+
+// TestNewSpaceModel tests the conversion of spaces into their Terraform model.
+func TestNewSpaceModel(t *testing.T) {
+	spaces := testSpaces()
+	tree := newSpaceTree(spaces)
+
+	model, diags := newSpaceModel(context.Background(), tree, spaces[3])
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("root/prod/network"), model.Path)
+	assert.Equal(t, types.StringValue("prod-01"), model.ParentSpaceID)
+
+	model, diags = newSpaceModel(context.Background(), tree, spaces[0])
+	require.False(t, diags.HasError())
+	assert.True(t, model.ParentSpaceID.IsNull())
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &spacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &spacesDataSource{}
+)
+
+// NewSpacesDataSource is a helper function to simplify the provider implementation.
+func NewSpacesDataSource() datasource.DataSource {
+	return &spacesDataSource{}
+}
+
+// spacesDataSource is the data source implementation.
+type spacesDataSource struct {
+	client *SpaceLiftClient
+}
+
+// spacesDataSourceModel maps the data source schema data.
+type spacesDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Spaces types.List   `tfsdk:"spaces"`
+}
+
+// spaceModel maps a space in the spaces list.
+type spaceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Path            types.String `tfsdk:"path"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	ParentSpaceID   types.String `tfsdk:"parent_space_id"`
+	Labels          types.List   `tfsdk:"labels"`
+	InheritEntities types.Bool   `tfsdk:"inherit_entities"`
+}
+
+// spaceAttrTypes are the attribute types of spaceModel.
+var spaceAttrTypes = map[string]attr.Type{
+	"id":               types.StringType,
+	"path":             types.StringType,
+	"name":             types.StringType,
+	"description":      types.StringType,
+	"parent_space_id":  types.StringType,
+	"labels":           types.ListType{ElemType: types.StringType},
+	"inherit_entities": types.BoolType,
+}
+
+// newSpaceModel converts a space into its Terraform model, resolving its path in tree.
+func newSpaceModel(ctx context.Context, tree spaceTree, space Space) (spaceModel, diag.Diagnostics) {
+	labels, diags := types.ListValueFrom(ctx, types.StringType, space.Labels)
+
+	return spaceModel{
+		ID:              types.StringValue(space.ID),
+		Path:            types.StringValue(tree.Path(space.ID)),
+		Name:            types.StringValue(space.Name),
+		Description:     types.StringValue(space.Description),
+		ParentSpaceID:   types.StringPointerValue(space.ParentSpace),
+		Labels:          labels,
+		InheritEntities: types.BoolValue(space.InheritEntities),
+	}, diags
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *spacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *spacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spaces"
+}
+
+// Schema defines the schema for the data source.
+func (d *spacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the full tree of SpaceLift spaces.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"spaces": schema.ListNestedAttribute{
+				Description: "All spaces, sorted by path.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the space.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "The path of the space, made of space names separated by slashes and starting at the topmost space the API token can see, such as \"root/prod/network\".",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the space.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the space.",
+							Computed:    true,
+						},
+						"parent_space_id": schema.StringAttribute{
+							Description: "The ID of the parent space. Null for the root space.",
+							Computed:    true,
+						},
+						"labels": schema.ListAttribute{
+							Description: "The labels of the space.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"inherit_entities": schema.BoolAttribute{
+							Description: "Whether the space inherits entities, such as contexts and policies, from its parent space.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state spacesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaces, err := d.client.GetSpaces()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Spaces",
+			"Could not read spaces: "+err.Error(),
+		)
+		return
+	}
+
+	tree := newSpaceTree(spaces)
+	models := []spaceModel{}
+	for _, space := range spaces {
+		model, diags := newSpaceModel(ctx, tree, space)
+		resp.Diagnostics.Append(diags...)
+		models = append(models, model)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		return models[i].Path.ValueString() < models[j].Path.ValueString()
+	})
+
+	spacesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: spaceAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("spaces")
+	state.Spaces = spacesValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
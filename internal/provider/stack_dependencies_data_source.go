@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &stackDependenciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &stackDependenciesDataSource{}
+)
+
+// NewStackDependenciesDataSource is a helper function to simplify the provider implementation.
+func NewStackDependenciesDataSource() datasource.DataSource {
+	return &stackDependenciesDataSource{}
+}
+
+// stackDependenciesDataSource is the data source implementation.
+type stackDependenciesDataSource struct {
+	client *SpaceLiftClient
+}
+
+// stackDependenciesDataSourceModel maps the data source schema data.
+type stackDependenciesDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	StackID    types.String `tfsdk:"stack_id"`
+	Upstream   types.List   `tfsdk:"upstream"`
+	Downstream types.List   `tfsdk:"downstream"`
+}
+
+// stackDependencyModel maps a dependency on, or of, another stack.
+type stackDependencyModel struct {
+	DependencyID types.String `tfsdk:"dependency_id"`
+	StackID      types.String `tfsdk:"stack_id"`
+	StackName    types.String `tfsdk:"stack_name"`
+	References   types.List   `tfsdk:"references"`
+}
+
+// stackDependencyReferenceModel maps an output reference of a dependency.
+type stackDependencyReferenceModel struct {
+	OutputName types.String `tfsdk:"output_name"`
+	InputName  types.String `tfsdk:"input_name"`
+}
+
+// stackDependencyReferenceAttrTypes are the attribute types of stackDependencyReferenceModel.
+var stackDependencyReferenceAttrTypes = map[string]attr.Type{
+	"output_name": types.StringType,
+	"input_name":  types.StringType,
+}
+
+// stackDependencyAttrTypes are the attribute types of stackDependencyModel.
+var stackDependencyAttrTypes = map[string]attr.Type{
+	"dependency_id": types.StringType,
+	"stack_id":      types.StringType,
+	"stack_name":    types.StringType,
+	"references":    types.ListType{ElemType: types.ObjectType{AttrTypes: stackDependencyReferenceAttrTypes}},
+}
+
+// stackDependencySchema returns the nested schema of a dependency. stackDescription
+// describes the stack on the other side of the dependency.
+func stackDependencySchema(stackDescription string) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"dependency_id": schema.StringAttribute{
+				Description: "The ID of the dependency.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the " + stackDescription + ".",
+				Computed:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "The name of the " + stackDescription + ".",
+				Computed:    true,
+			},
+			"references": schema.ListNestedAttribute{
+				Description: "The output references of the dependency.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"output_name": schema.StringAttribute{
+							Description: "The name of the output of the upstream stack.",
+							Computed:    true,
+						},
+						"input_name": schema.StringAttribute{
+							Description: "The name of the input of the downstream stack the output is passed to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// stackDependenciesValue converts dependencies into a list value. When upstream
+// is true the upstream stack of each dependency is reported, otherwise the
+// downstream stack is.
+func stackDependenciesValue(ctx context.Context, dependencies []StackDependency, upstream bool) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	models := []stackDependencyModel{}
+	for _, dependency := range dependencies {
+		stack := dependency.Stack
+		if upstream {
+			stack = dependency.DependsOnStack
+		}
+
+		references := []stackDependencyReferenceModel{}
+		for _, reference := range dependency.References {
+			references = append(references, stackDependencyReferenceModel{
+				OutputName: types.StringValue(reference.OutputName),
+				InputName:  types.StringValue(reference.InputName),
+			})
+		}
+
+		referencesValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: stackDependencyReferenceAttrTypes}, references)
+		diags.Append(d...)
+
+		models = append(models, stackDependencyModel{
+			DependencyID: types.StringValue(dependency.ID),
+			StackID:      types.StringValue(stack.ID),
+			StackName:    types.StringValue(stack.Name),
+			References:   referencesValue,
+		})
+	}
+
+	value, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: stackDependencyAttrTypes}, models)
+	diags.Append(d...)
+
+	return value, diags
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *stackDependenciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *stackDependenciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_dependencies"
+}
+
+// Schema defines the schema for the data source.
+func (d *stackDependenciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the upstream and downstream dependencies of a SpaceLift stack, along with their output references.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack.",
+				Required:    true,
+			},
+			"upstream": schema.ListNestedAttribute{
+				Description:  "The stacks this stack depends on.",
+				Computed:     true,
+				NestedObject: stackDependencySchema("upstream stack"),
+			},
+			"downstream": schema.ListNestedAttribute{
+				Description:  "The stacks that depend on this stack.",
+				Computed:     true,
+				NestedObject: stackDependencySchema("downstream stack"),
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *stackDependenciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state stackDependenciesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	dependencies, err := d.client.GetStackDependencies(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Dependencies",
+			"Could not read stack dependencies: "+err.Error(),
+		)
+		return
+	}
+
+	upstream, diags := stackDependenciesValue(ctx, dependencies.DependsOn, true)
+	resp.Diagnostics.Append(diags...)
+	downstream, diags := stackDependenciesValue(ctx, dependencies.IsDependedOnBy, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(stackID)
+	state.Upstream = upstream
+	state.Downstream = downstream
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStackDependenciesDataSourceMetadata tests the data source metadata.
+func TestStackDependenciesDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackDependenciesDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_stack_dependencies", resp.TypeName)
+}
+
+// TestStackDependenciesDataSourceSchema tests the data source schema.
+func TestStackDependenciesDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackDependenciesDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, resp.Schema.Attributes["stack_id"])
+	assert.NotNil(t, resp.Schema.Attributes["upstream"])
+	assert.NotNil(t, resp.Schema.Attributes["downstream"])
+}
+
+// TestStackDependenciesValue tests that the stack on the requested side of each
+// dependency is reported.
+func TestStackDependenciesValue(t *testing.T) {
+	ctx := context.Background()
+	dependencies := []StackDependency{
+		{
+			ID:             "dep-1",
+			Stack:          StackReference{ID: "app", Name: "App"},
+			DependsOnStack: StackReference{ID: "network", Name: "Network"},
+			References: []StackDependencyReference{
+				{OutputName: "vpc_id", InputName: "TF_VAR_vpc_id"},
+			},
+		},
+	}
+
+	for upstream, wantStackID := range map[bool]string{true: "network", false: "app"} {
+		value, diags := stackDependenciesValue(ctx, dependencies, upstream)
+		require.False(t, diags.HasError())
+
+		var models []stackDependencyModel
+		require.False(t, value.ElementsAs(ctx, &models, false).HasError())
+		require.Len(t, models, 1)
+		assert.Equal(t, types.StringValue(wantStackID), models[0].StackID)
+
+		var references []stackDependencyReferenceModel
+		require.False(t, models[0].References.ElementsAs(ctx, &references, false).HasError())
+		assert.Equal(t, []stackDependencyReferenceModel{
+			{OutputName: types.StringValue("vpc_id"), InputName: types.StringValue("TF_VAR_vpc_id")},
+		}, references)
+	}
+}
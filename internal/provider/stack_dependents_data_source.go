@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &stackDependentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &stackDependentsDataSource{}
+)
+
+// NewStackDependentsDataSource is a helper function to simplify the provider implementation.
+func NewStackDependentsDataSource() datasource.DataSource {
+	return &stackDependentsDataSource{}
+}
+
+// stackDependentsDataSource is the data source implementation.
+type stackDependentsDataSource struct {
+	client *SpaceLiftClient
+}
+
+// stackDependentsDataSourceModel maps the data source schema data.
+type stackDependentsDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	StackID           types.String `tfsdk:"stack_id"`
+	OutputName        types.String `tfsdk:"output_name"`
+	Dependents        types.List   `tfsdk:"dependents"`
+	DependentStackIDs types.List   `tfsdk:"dependent_stack_ids"`
+}
+
+// filterDependenciesByOutput returns the dependencies referencing the given
+// output, keeping only the references to that output.
+func filterDependenciesByOutput(dependencies []StackDependency, outputName string) []StackDependency {
+	filtered := []StackDependency{}
+	for _, dependency := range dependencies {
+		var references []StackDependencyReference
+		for _, reference := range dependency.References {
+			if reference.OutputName == outputName {
+				references = append(references, reference)
+			}
+		}
+
+		if len(references) > 0 {
+			dependency.References = references
+			filtered = append(filtered, dependency)
+		}
+	}
+	return filtered
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *stackDependentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *stackDependentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_dependents"
+}
+
+// Schema defines the schema for the data source.
+func (d *stackDependentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the stacks that depend on a SpaceLift stack, optionally only those consuming a given output.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the upstream SpaceLift stack.",
+				Required:    true,
+			},
+			"output_name": schema.StringAttribute{
+				Description: "Only return stacks that reference this output, and only their references to it. If not set, all downstream stacks are returned, including those depending on the stack without referencing any output.",
+				Optional:    true,
+			},
+			"dependents": schema.ListNestedAttribute{
+				Description:  "The downstream stacks and their output references.",
+				Computed:     true,
+				NestedObject: stackDependencySchema("downstream stack"),
+			},
+			"dependent_stack_ids": schema.ListAttribute{
+				Description: "The IDs of the downstream stacks.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *stackDependentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state stackDependentsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	dependencies, err := d.client.GetStackDependencies(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Dependencies",
+			"Could not read stack dependencies: "+err.Error(),
+		)
+		return
+	}
+
+	id := stackID
+	dependents := dependencies.IsDependedOnBy
+	if !state.OutputName.IsNull() {
+		id = stackID + ":" + state.OutputName.ValueString()
+		dependents = filterDependenciesByOutput(dependents, state.OutputName.ValueString())
+	}
+
+	stackIDs := []string{}
+	for _, dependency := range dependents {
+		stackIDs = append(stackIDs, dependency.Stack.ID)
+	}
+
+	dependentsValue, diags := stackDependenciesValue(ctx, dependents, false)
+	resp.Diagnostics.Append(diags...)
+	stackIDsValue, diags := types.ListValueFrom(ctx, types.StringType, stackIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(id)
+	state.Dependents = dependentsValue
+	state.DependentStackIDs = stackIDsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStackDependentsDataSourceMetadata tests the data source metadata.
+func TestStackDependentsDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackDependentsDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_stack_dependents", resp.TypeName)
+}
+
+// TestStackDependentsDataSourceSchema tests the data source schema.
+func TestStackDependentsDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackDependentsDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "output_name", "dependents", "dependent_stack_ids"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestFilterDependenciesByOutput tests that only references to the output are kept.
+func TestFilterDependenciesByOutput(t *testing.T) {
+	dependencies := []StackDependency{
+		{
+			ID:    "dep-1",
+			Stack: StackReference{ID: "app"},
+			References: []StackDependencyReference{
+				{OutputName: "vpc_id", InputName: "TF_VAR_vpc_id"},
+				{OutputName: "subnet_ids", InputName: "TF_VAR_subnet_ids"},
+			},
+		},
+		{
+			ID:         "dep-2",
+			Stack:      StackReference{ID: "dns"},
+			References: []StackDependencyReference{{OutputName: "zone_id", InputName: "TF_VAR_zone_id"}},
+		},
+		{ID: "dep-3", Stack: StackReference{ID: "monitoring"}},
+	}
+
+	filtered := filterDependenciesByOutput(dependencies, "vpc_id")
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "app", filtered[0].Stack.ID)
+	assert.Equal(t, []StackDependencyReference{{OutputName: "vpc_id", InputName: "TF_VAR_vpc_id"}}, filtered[0].References)
+	assert.Len(t, dependencies[0].References, 2)
+
+	assert.Empty(t, filterDependenciesByOutput(dependencies, "unused"))
+}
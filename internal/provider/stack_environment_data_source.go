@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// configOriginStack is the origin of config elements defined on the stack itself.
+	configOriginStack = "stack"
+	// configOriginContext is the origin of config elements defined by an attached context.
+	configOriginContext = "context"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &stackEnvironmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &stackEnvironmentDataSource{}
+)
+
+// NewStackEnvironmentDataSource is a helper function to simplify the provider implementation.
+func NewStackEnvironmentDataSource() datasource.DataSource {
+	return &stackEnvironmentDataSource{}
+}
+
+// stackEnvironmentDataSource is the data source implementation.
+type stackEnvironmentDataSource struct {
+	client *SpaceLiftClient
+}
+
+// stackEnvironmentDataSourceModel maps the data source schema data.
+type stackEnvironmentDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	StackID              types.String `tfsdk:"stack_id"`
+	EnvironmentVariables types.List   `tfsdk:"environment_variables"`
+	MountedFiles         types.List   `tfsdk:"mounted_files"`
+	Environment          types.Map    `tfsdk:"environment"`
+}
+
+// stackConfigElementModel maps an environment variable or mounted file of a stack.
+type stackConfigElementModel struct {
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	WriteOnly   types.Bool   `tfsdk:"write_only"`
+	Description types.String `tfsdk:"description"`
+	Origin      types.String `tfsdk:"origin"`
+	ContextID   types.String `tfsdk:"context_id"`
+}
+
+// stackConfigElementAttrTypes are the attribute types of stackConfigElementModel.
+var stackConfigElementAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"value":       types.StringType,
+	"write_only":  types.BoolType,
+	"description": types.StringType,
+	"origin":      types.StringType,
+	"context_id":  types.StringType,
+}
+
+// stackConfigElementSchema returns the nested schema of a stack environment
+// variable or mounted file, which adds its origin to configElementSchema.
+func stackConfigElementSchema(nameDescription string) schema.NestedAttributeObject {
+	nested := configElementSchema(nameDescription)
+	nested.Attributes["origin"] = schema.StringAttribute{
+		Description: fmt.Sprintf("Where the entry is defined: %q for the stack itself or %q for an attached context.", configOriginStack, configOriginContext),
+		Computed:    true,
+	}
+	nested.Attributes["context_id"] = schema.StringAttribute{
+		Description: "The ID of the attached context defining the entry. Null for entries defined on the stack.",
+		Computed:    true,
+	}
+	return nested
+}
+
+// stackConfigElementsValue converts the stack config elements of the given type into a list value.
+func stackConfigElementsValue(ctx context.Context, elements []StackConfigElement, elementType string) (types.List, diag.Diagnostics) {
+	models := []stackConfigElementModel{}
+	for _, element := range elements {
+		if element.Type != elementType {
+			continue
+		}
+
+		origin := configOriginStack
+		if element.ContextID != nil {
+			origin = configOriginContext
+		}
+
+		models = append(models, stackConfigElementModel{
+			Name:        types.StringValue(element.ID),
+			Value:       types.StringPointerValue(element.Value),
+			WriteOnly:   types.BoolValue(element.WriteOnly),
+			Description: types.StringPointerValue(element.Description),
+			Origin:      types.StringValue(origin),
+			ContextID:   types.StringPointerValue(element.ContextID),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: stackConfigElementAttrTypes}, models)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *stackEnvironmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *stackEnvironmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_environment"
+}
+
+// Schema defines the schema for the data source.
+func (d *stackEnvironmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the effective non-secret environment of a SpaceLift stack, merging its own configuration with that of its attached contexts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack.",
+				Required:    true,
+			},
+			"environment_variables": schema.ListNestedAttribute{
+				Description:  "The effective environment variables of the stack. Write-only variables are listed without their values.",
+				Computed:     true,
+				NestedObject: stackConfigElementSchema("The name of the environment variable."),
+			},
+			"mounted_files": schema.ListNestedAttribute{
+				Description:  "The effective mounted files of the stack. Write-only files are listed without their contents.",
+				Computed:     true,
+				NestedObject: stackConfigElementSchema("The path of the mounted file, relative to /mnt/workspace."),
+			},
+			"environment": schema.MapAttribute{
+				Description: "The readable effective environment variables of the stack, keyed by name. Write-only variables are omitted.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *stackEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state stackEnvironmentDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	elements, err := d.client.GetStackEnvironment(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Environment",
+			"Could not read stack environment: "+err.Error(),
+		)
+		return
+	}
+
+	configElements := make([]ConfigElement, len(elements))
+	for i, element := range elements {
+		configElements[i] = element.ConfigElement
+	}
+
+	environmentVariables, diags := stackConfigElementsValue(ctx, elements, configTypeEnvironmentVariable)
+	resp.Diagnostics.Append(diags...)
+	mountedFiles, diags := stackConfigElementsValue(ctx, elements, configTypeFileMount)
+	resp.Diagnostics.Append(diags...)
+	environment, diags := environmentValue(ctx, configElements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(stackID)
+	state.EnvironmentVariables = environmentVariables
+	state.MountedFiles = mountedFiles
+	state.Environment = environment
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStackEnvironmentDataSourceMetadata tests the data source metadata.
+func TestStackEnvironmentDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackEnvironmentDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_stack_environment", resp.TypeName)
+}
+
+// TestStackEnvironmentDataSourceSchema tests the data source schema.
+func TestStackEnvironmentDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackEnvironmentDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "environment_variables", "mounted_files", "environment"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestStackConfigElementsValue tests that stack config elements are annotated with their origin.
+func TestStackConfigElementsValue(t *testing.T) {
+	ctx := context.Background()
+	region := "eu-west-1"
+	contextID := "defaults"
+	elements := []StackConfigElement{
+		{ConfigElement: ConfigElement{ID: "REGION", Type: configTypeEnvironmentVariable, Value: &region}},
+		{ConfigElement: ConfigElement{ID: "DB_PASSWORD", Type: configTypeEnvironmentVariable, WriteOnly: true}, ContextID: &contextID},
+		{ConfigElement: ConfigElement{ID: "kubeconfig", Type: configTypeFileMount, WriteOnly: true}, ContextID: &contextID},
+	}
+
+	environmentVariables, diags := stackConfigElementsValue(ctx, elements, configTypeEnvironmentVariable)
+	require.False(t, diags.HasError())
+	var models []stackConfigElementModel
+	require.False(t, environmentVariables.ElementsAs(ctx, &models, false).HasError())
+	require.Len(t, models, 2)
+	assert.Equal(t, types.StringValue(configOriginStack), models[0].Origin)
+	assert.True(t, models[0].ContextID.IsNull())
+	assert.Equal(t, types.StringValue(configOriginContext), models[1].Origin)
+	assert.Equal(t, types.StringValue("defaults"), models[1].ContextID)
+	assert.True(t, models[1].Value.IsNull())
+
+	mountedFiles, diags := stackConfigElementsValue(ctx, elements, configTypeFileMount)
+	require.False(t, diags.HasError())
+	assert.Len(t, mountedFiles.Elements(), 1)
+}
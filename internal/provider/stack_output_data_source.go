@@ -12,8 +12,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &stackOutputDataSource{}
-	_ datasource.DataSourceWithConfigure = &stackOutputDataSource{}
+	_ datasource.DataSource                   = &stackOutputDataSource{}
+	_ datasource.DataSourceWithConfigure      = &stackOutputDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &stackOutputDataSource{}
 )
 
 // NewStackOutputDataSource is a helper function to simplify the provider implementation.
@@ -28,11 +29,32 @@ type stackOutputDataSource struct {
 
 // stackOutputDataSourceModel maps the data source schema data.
 type stackOutputDataSourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	StackID    types.String `tfsdk:"stack_id"`
-	OutputName types.String `tfsdk:"output_name"`
-	Value      types.String `tfsdk:"value"`
-	LastCheck  types.String `tfsdk:"last_check"`
+	ID             types.String `tfsdk:"id"`
+	StackID        types.String `tfsdk:"stack_id"`
+	StackName      types.String `tfsdk:"stack_name"`
+	Space          types.String `tfsdk:"space"`
+	Source         types.String `tfsdk:"source"`
+	RunID          types.String `tfsdk:"run_id"`
+	CommitSHA      types.String `tfsdk:"commit_sha"`
+	WaitForIdle    types.Bool   `tfsdk:"wait_for_idle"`
+	Timeout        types.String `tfsdk:"timeout"`
+	PollInterval   types.String `tfsdk:"poll_interval"`
+	OutputName     types.String `tfsdk:"output_name"`
+	Value          types.String `tfsdk:"value"`
+	Type           types.String `tfsdk:"type"`
+	Sensitive      types.Bool   `tfsdk:"sensitive"`
+	SensitiveValue types.String `tfsdk:"sensitive_value"`
+	LastCheck      types.String `tfsdk:"last_check"`
+}
+
+// findStackOutput returns the output with the given name, or nil if there is none.
+func findStackOutput(outputs []StackOutput, name string) *StackOutput {
+	for i := range outputs {
+		if outputs[i].ID == name {
+			return &outputs[i]
+		}
+	}
+	return nil
 }
 
 // Configure adds the provider configured client to the data source.
@@ -68,15 +90,62 @@ func (d *stackOutputDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Computed:    true,
 			},
 			"stack_id": schema.StringAttribute{
-				Description: "The ID of the SpaceLift stack.",
-				Required:    true,
+				Description: "The ID of the SpaceLift stack. Exactly one of stack_id and stack_name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "The name of the SpaceLift stack, as an alternative to stack_id that survives stack renames. Exactly one of stack_id and stack_name must be set.",
+				Optional:    true,
+			},
+			"space": schema.StringAttribute{
+				Description: "The ID or path, such as \"root/prod/network\", of the space the stack named by stack_name belongs to. If not set, the name must be unique across all spaces.",
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Where to read outputs from: \"outputs\" (default) for the outputs reported by SpaceLift, or \"state\" for the root module outputs of the stack's SpaceLift-managed Terraform state, which also exposes output types and sensitivity. Outputs as of run_id or commit_sha are always read from state.",
+				Optional:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "Read outputs as of this finished tracked run, from the stack's state history. When commit_sha is set, this is the ID of the run it resolved to. Requires SpaceLift-managed state. At most one of run_id and commit_sha can be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"commit_sha": schema.StringAttribute{
+				Description: "Read outputs as of the latest finished tracked run for the commit whose SHA starts with this value, from the stack's state history. Requires SpaceLift-managed state. At most one of run_id and commit_sha can be set.",
+				Optional:    true,
+			},
+			"wait_for_idle": schema.BoolAttribute{
+				Description: "Wait until no tracked run is active on the stack before reading outputs, so that outputs are not read while the stack is mid-apply. Defaults to false.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the stack to be idle, as a Go duration such as \"15m\". The read fails with the final stack state if the stack is still busy. Defaults to \"10m\". Requires wait_for_idle.",
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often to check whether the stack is idle, as a Go duration such as \"30s\". Defaults to \"15s\". Requires wait_for_idle.",
+				Optional:    true,
 			},
 			"output_name": schema.StringAttribute{
 				Description: "The name of the output to retrieve.",
 				Required:    true,
 			},
 			"value": schema.StringAttribute{
-				Description: "The value of the specified output.",
+				Description: "The value of the specified output. When outputs are read from state, string outputs are returned as-is, all other outputs are JSON-encoded, and the value of an output marked as sensitive is only available in sensitive_value.",
+				Computed:    true,
+			},
+			"sensitive_value": schema.StringAttribute{
+				Description: "The value of the specified output, encoded like value, if it is marked as sensitive. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The JSON-encoded Terraform type of the output, such as \"string\" or [\"list\",\"string\"]. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
+				Computed:    true,
+			},
+			"sensitive": schema.BoolAttribute{
+				Description: "Whether the output is marked as sensitive. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
 				Computed:    true,
 			},
 			"last_check": schema.StringAttribute{
@@ -87,6 +156,21 @@ func (d *stackOutputDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 	}
 }
 
+// ValidateConfig checks that the stack is selected either by ID or by name and
+// that the output source, version and wait options are supported.
+func (d *stackOutputDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config stackOutputDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateStackSelector(config.StackID, config.StackName, config.Space)...)
+	resp.Diagnostics.Append(validateOutputSource(config.Source)...)
+	resp.Diagnostics.Append(validateOutputVersion(config.Source, config.RunID, config.CommitSHA)...)
+	resp.Diagnostics.Append(validateWaitOptions(config.WaitForIdle, config.Timeout, config.PollInterval)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *stackOutputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state stackOutputDataSourceModel
@@ -96,10 +180,28 @@ func (d *stackOutputDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	// Resolve the stack, which may be selected by name
+	stackID, diags := resolveStackID(d.client, state.StackID, state.StackName, state.Space)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for any active tracked run to finish if requested
+	waitOpts, diags := newWaitOptions(state.WaitForIdle, state.Timeout, state.PollInterval)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(waitForIdle(ctx, d.client, stackID, waitOpts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get stack outputs from SpaceLift
-	stackID := state.StackID.ValueString()
 	outputName := state.OutputName.ValueString()
-	outputs, err := d.client.GetStackOutputs(stackID)
+	opts := newOutputOptions(state.Source, state.RunID, state.CommitSHA)
+	outputs, runID, err := getOutputs(d.client, stackID, opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading SpaceLift Stack Outputs",
@@ -109,17 +211,8 @@ func (d *stackOutputDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Find the specific output
-	var outputValue string
-	found := false
-	for _, output := range outputs {
-		if output.ID == outputName {
-			outputValue = output.Value
-			found = true
-			break
-		}
-	}
-
-	if !found {
+	found := findStackOutput(outputs, outputName)
+	if found == nil {
 		resp.Diagnostics.AddError(
 			"Output Not Found",
 			fmt.Sprintf("Output with name '%s' not found in stack '%s'", outputName, stackID),
@@ -128,8 +221,26 @@ func (d *stackOutputDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Update state with the data
+	state.RunID = types.StringNull()
+	if runID != "" {
+		state.RunID = types.StringValue(runID)
+	}
+	state.StackID = types.StringValue(stackID)
 	state.ID = types.StringValue(stackID + ":" + outputName)
-	state.Value = types.StringValue(outputValue)
+	state.Value = types.StringValue(found.Value)
+	state.Type = types.StringNull()
+	state.Sensitive = types.BoolNull()
+	state.SensitiveValue = types.StringNull()
+	if opts.fromState() {
+		state.Type = types.StringValue(found.Type)
+		state.Sensitive = types.BoolValue(found.Sensitive)
+	}
+
+	// Outputs marked as sensitive in state are kept apart so they are never shown in plans
+	if found.Sensitive {
+		state.Value = types.StringNull()
+		state.SensitiveValue = types.StringValue(found.Value)
+	}
 	state.LastCheck = types.StringValue(time.Now().Format(time.RFC3339))
 
 	// Set state
@@ -138,4 +249,4 @@ func (d *stackOutputDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-} 
\ No newline at end of file
+}
@@ -44,9 +44,23 @@ func TestStackOutputDataSourceSchema(t *testing.T) {
 
 	// Assert that the schema has the expected attributes
 	assert.NotNil(t, resp.Schema.Attributes["stack_id"])
+	assert.NotNil(t, resp.Schema.Attributes["stack_name"])
+	assert.NotNil(t, resp.Schema.Attributes["space"])
+	assert.NotNil(t, resp.Schema.Attributes["source"])
+	assert.NotNil(t, resp.Schema.Attributes["run_id"])
+	assert.NotNil(t, resp.Schema.Attributes["commit_sha"])
+	assert.NotNil(t, resp.Schema.Attributes["wait_for_idle"])
+	assert.NotNil(t, resp.Schema.Attributes["timeout"])
+	assert.NotNil(t, resp.Schema.Attributes["poll_interval"])
 	assert.NotNil(t, resp.Schema.Attributes["output_name"])
 	assert.NotNil(t, resp.Schema.Attributes["value"])
+	assert.NotNil(t, resp.Schema.Attributes["type"])
+	assert.NotNil(t, resp.Schema.Attributes["sensitive"])
 	assert.NotNil(t, resp.Schema.Attributes["last_check"])
+
+	// Assert that values of sensitive outputs are kept in a sensitive attribute
+	assert.False(t, resp.Schema.Attributes["value"].IsSensitive())
+	assert.True(t, resp.Schema.Attributes["sensitive_value"].IsSensitive())
 }
 
 // TestStackOutputsDataSourceMetadata tests the data source metadata.
@@ -85,6 +99,21 @@ func TestStackOutputsDataSourceSchema(t *testing.T) {
 
 	// Assert that the schema has the expected attributes
 	assert.NotNil(t, resp.Schema.Attributes["stack_id"])
+	assert.NotNil(t, resp.Schema.Attributes["stack_name"])
+	assert.NotNil(t, resp.Schema.Attributes["space"])
+	assert.NotNil(t, resp.Schema.Attributes["source"])
+	assert.NotNil(t, resp.Schema.Attributes["run_id"])
+	assert.NotNil(t, resp.Schema.Attributes["commit_sha"])
+	assert.NotNil(t, resp.Schema.Attributes["wait_for_idle"])
+	assert.NotNil(t, resp.Schema.Attributes["timeout"])
+	assert.NotNil(t, resp.Schema.Attributes["poll_interval"])
 	assert.NotNil(t, resp.Schema.Attributes["outputs"])
+	assert.NotNil(t, resp.Schema.Attributes["fail_on_drift"])
+	assert.NotNil(t, resp.Schema.Attributes["output_types"])
+	assert.NotNil(t, resp.Schema.Attributes["sensitive_outputs"])
 	assert.NotNil(t, resp.Schema.Attributes["last_check"])
+
+	// Assert that values of sensitive outputs are kept in a sensitive attribute
+	assert.False(t, resp.Schema.Attributes["outputs"].IsSensitive())
+	assert.True(t, resp.Schema.Attributes["sensitive_values"].IsSensitive())
 }
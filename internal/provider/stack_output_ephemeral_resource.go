@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = &stackOutputEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &stackOutputEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &stackOutputEphemeralResource{}
+)
+
+// NewStackOutputEphemeralResource is a helper function to simplify the provider implementation.
+func NewStackOutputEphemeralResource() ephemeral.EphemeralResource {
+	return &stackOutputEphemeralResource{}
+}
+
+// stackOutputEphemeralResource is the ephemeral resource implementation.
+type stackOutputEphemeralResource struct {
+	client *SpaceLiftClient
+}
+
+// stackOutputEphemeralResourceModel maps the ephemeral resource schema data.
+type stackOutputEphemeralResourceModel struct {
+	StackID    types.String `tfsdk:"stack_id"`
+	StackName  types.String `tfsdk:"stack_name"`
+	Space      types.String `tfsdk:"space"`
+	Source     types.String `tfsdk:"source"`
+	RunID      types.String `tfsdk:"run_id"`
+	CommitSHA  types.String `tfsdk:"commit_sha"`
+	OutputName types.String `tfsdk:"output_name"`
+	Value      types.String `tfsdk:"value"`
+	Type       types.String `tfsdk:"type"`
+	Sensitive  types.Bool   `tfsdk:"sensitive"`
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *stackOutputEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *stackOutputEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_output"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *stackOutputEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a single output from a SpaceLift stack without persisting it in plan or state files. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack. Exactly one of stack_id and stack_name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "The name of the SpaceLift stack, as an alternative to stack_id that survives stack renames. Exactly one of stack_id and stack_name must be set.",
+				Optional:    true,
+			},
+			"space": schema.StringAttribute{
+				Description: "The ID or path, such as \"root/prod/network\", of the space the stack named by stack_name belongs to. If not set, the name must be unique across all spaces.",
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Where to read outputs from: \"outputs\" (default) for the outputs reported by SpaceLift, or \"state\" for the root module outputs of the stack's SpaceLift-managed Terraform state, which also exposes output types and sensitivity. Outputs as of run_id or commit_sha are always read from state.",
+				Optional:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "Read outputs as of this finished tracked run, from the stack's state history. When commit_sha is set, this is the ID of the run it resolved to. Requires SpaceLift-managed state. At most one of run_id and commit_sha can be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"commit_sha": schema.StringAttribute{
+				Description: "Read outputs as of the latest finished tracked run for the commit whose SHA starts with this value, from the stack's state history. Requires SpaceLift-managed state. At most one of run_id and commit_sha can be set.",
+				Optional:    true,
+			},
+			"output_name": schema.StringAttribute{
+				Description: "The name of the output to retrieve.",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the specified output. When outputs are read from state, string outputs are returned as-is and all other outputs are JSON-encoded.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The JSON-encoded Terraform type of the output, such as \"string\" or [\"list\",\"string\"]. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
+				Computed:    true,
+			},
+			"sensitive": schema.BoolAttribute{
+				Description: "Whether the output is marked as sensitive. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the stack is selected either by ID or by name and
+// that the output source and version are supported.
+func (e *stackOutputEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config stackOutputEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateStackSelector(config.StackID, config.StackName, config.Space)...)
+	resp.Diagnostics.Append(validateOutputSource(config.Source)...)
+	resp.Diagnostics.Append(validateOutputVersion(config.Source, config.RunID, config.CommitSHA)...)
+}
+
+// Open fetches the output. The result is only held in memory by Terraform for
+// the duration of the operation.
+func (e *stackOutputEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data stackOutputEphemeralResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the stack, which may be selected by name
+	stackID, diags := resolveStackID(e.client, data.StackID, data.StackName, data.Space)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get stack outputs from SpaceLift
+	outputName := data.OutputName.ValueString()
+	opts := newOutputOptions(data.Source, data.RunID, data.CommitSHA)
+	outputs, runID, err := getOutputs(e.client, stackID, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Outputs",
+			"Could not read stack outputs: "+err.Error(),
+		)
+		return
+	}
+
+	found := findStackOutput(outputs, outputName)
+	if found == nil {
+		resp.Diagnostics.AddError(
+			"Output Not Found",
+			fmt.Sprintf("Output with name '%s' not found in stack '%s'", outputName, stackID),
+		)
+		return
+	}
+
+	data.RunID = types.StringNull()
+	if runID != "" {
+		data.RunID = types.StringValue(runID)
+	}
+	data.StackID = types.StringValue(stackID)
+	data.Value = types.StringValue(found.Value)
+	data.Type = types.StringNull()
+	data.Sensitive = types.BoolNull()
+	if opts.fromState() {
+		data.Type = types.StringValue(found.Type)
+		data.Sensitive = types.BoolValue(found.Sensitive)
+	}
+
+	// Set result
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStackOutputEphemeralResourceMetadata tests the ephemeral resource metadata.
+func TestStackOutputEphemeralResourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	e := &stackOutputEphemeralResource{}
+
+	req := ephemeral.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &ephemeral.MetadataResponse{}
+	e.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_stack_output", resp.TypeName)
+}
+
+// TestStackOutputEphemeralResourceSchema tests the ephemeral resource schema.
+func TestStackOutputEphemeralResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	e := &stackOutputEphemeralResource{}
+
+	resp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "stack_name", "space", "source", "run_id", "commit_sha", "output_name", "value", "type", "sensitive"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+	assert.True(t, resp.Schema.Attributes["output_name"].IsRequired())
+
+	value, ok := resp.Schema.Attributes["value"].(schema.StringAttribute)
+	require.True(t, ok)
+	assert.True(t, value.IsComputed())
+	assert.True(t, value.IsSensitive())
+}
+
+// TestFindStackOutput tests looking up an output by name.
+func TestFindStackOutput(t *testing.T) {
+	outputs := []StackOutput{
+		{ID: "vpc_id", Value: "vpc-123"},
+		{ID: "db_password", Value: "hunter2", Sensitive: true},
+	}
+
+	found := findStackOutput(outputs, "db_password")
+	require.NotNil(t, found)
+	assert.Equal(t, "hunter2", found.Value)
+	assert.Nil(t, findStackOutput(outputs, "missing"))
+}
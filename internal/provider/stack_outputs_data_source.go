@@ -8,13 +8,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &stackOutputsDataSource{}
-	_ datasource.DataSourceWithConfigure = &stackOutputsDataSource{}
+	_ datasource.DataSource                   = &stackOutputsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &stackOutputsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &stackOutputsDataSource{}
 )
 
 // NewStackOutputsDataSource is a helper function to simplify the provider implementation.
@@ -29,10 +31,22 @@ type stackOutputsDataSource struct {
 
 // stackOutputsDataSourceModel maps the data source schema data.
 type stackOutputsDataSourceModel struct {
-	ID        types.String            `tfsdk:"id"`
-	StackID   types.String            `tfsdk:"stack_id"`
-	Outputs   types.Map               `tfsdk:"outputs"`
-	LastCheck types.String            `tfsdk:"last_check"`
+	ID               types.String `tfsdk:"id"`
+	StackID          types.String `tfsdk:"stack_id"`
+	StackName        types.String `tfsdk:"stack_name"`
+	Space            types.String `tfsdk:"space"`
+	Source           types.String `tfsdk:"source"`
+	FailOnDrift      types.Bool   `tfsdk:"fail_on_drift"`
+	RunID            types.String `tfsdk:"run_id"`
+	CommitSHA        types.String `tfsdk:"commit_sha"`
+	WaitForIdle      types.Bool   `tfsdk:"wait_for_idle"`
+	Timeout          types.String `tfsdk:"timeout"`
+	PollInterval     types.String `tfsdk:"poll_interval"`
+	Outputs          types.Map    `tfsdk:"outputs"`
+	OutputTypes      types.Map    `tfsdk:"output_types"`
+	SensitiveOutputs types.List   `tfsdk:"sensitive_outputs"`
+	SensitiveValues  types.Map    `tfsdk:"sensitive_values"`
+	LastCheck        types.String `tfsdk:"last_check"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -68,12 +82,66 @@ func (d *stackOutputsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:    true,
 			},
 			"stack_id": schema.StringAttribute{
-				Description: "The ID of the SpaceLift stack.",
-				Required:    true,
+				Description: "The ID of the SpaceLift stack. Exactly one of stack_id and stack_name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "The name of the SpaceLift stack, as an alternative to stack_id that survives stack renames. Exactly one of stack_id and stack_name must be set.",
+				Optional:    true,
+			},
+			"space": schema.StringAttribute{
+				Description: "The ID or path, such as \"root/prod/network\", of the space the stack named by stack_name belongs to. If not set, the name must be unique across all spaces.",
+				Optional:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Where to read outputs from: \"outputs\" (default) for the outputs reported by SpaceLift, or \"state\" for the root module outputs of the stack's SpaceLift-managed Terraform state, which also exposes output types and sensitivity. Outputs as of run_id or commit_sha are always read from state.",
+				Optional:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "Read outputs as of this finished tracked run, from the stack's state history. When commit_sha is set, this is the ID of the run it resolved to. Requires SpaceLift-managed state. At most one of run_id and commit_sha can be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"commit_sha": schema.StringAttribute{
+				Description: "Read outputs as of the latest finished tracked run for the commit whose SHA starts with this value, from the stack's state history. Requires SpaceLift-managed state. At most one of run_id and commit_sha can be set.",
+				Optional:    true,
+			},
+			"wait_for_idle": schema.BoolAttribute{
+				Description: "Wait until no tracked run is active on the stack before reading outputs, so that outputs are not read while the stack is mid-apply. Defaults to false.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the stack to be idle, as a Go duration such as \"15m\". The read fails with the final stack state if the stack is still busy. Defaults to \"10m\". Requires wait_for_idle.",
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often to check whether the stack is idle, as a Go duration such as \"30s\". Defaults to \"15s\". Requires wait_for_idle.",
+				Optional:    true,
+			},
+			"fail_on_drift": schema.BoolAttribute{
+				Description: "Fail the read if drift detection found any resource of the stack to have drifted. Defaults to false.",
+				Optional:    true,
 			},
 			"outputs": schema.MapAttribute{
-				Description: "The outputs of the SpaceLift stack.",
+				Description: "The outputs of the SpaceLift stack. When outputs are read from state, string outputs are returned as-is, all other outputs are JSON-encoded, and outputs marked as sensitive are only available in sensitive_values.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"output_types": schema.MapAttribute{
+				Description: "The JSON-encoded Terraform type of each output, such as \"string\" or [\"list\",\"string\"]. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"sensitive_outputs": schema.ListAttribute{
+				Description: "The names of the outputs marked as sensitive. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"sensitive_values": schema.MapAttribute{
+				Description: "The values of the outputs marked as sensitive, encoded like outputs. Only set when outputs are read from state, that is when source is \"state\" or run_id or commit_sha is set.",
 				Computed:    true,
+				Sensitive:   true,
 				ElementType: types.StringType,
 			},
 			"last_check": schema.StringAttribute{
@@ -84,6 +152,21 @@ func (d *stackOutputsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	}
 }
 
+// ValidateConfig checks that the stack is selected either by ID or by name and
+// that the output source, version and wait options are supported.
+func (d *stackOutputsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config stackOutputsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateStackSelector(config.StackID, config.StackName, config.Space)...)
+	resp.Diagnostics.Append(validateOutputSource(config.Source)...)
+	resp.Diagnostics.Append(validateOutputVersion(config.Source, config.RunID, config.CommitSHA)...)
+	resp.Diagnostics.Append(validateWaitOptions(config.WaitForIdle, config.Timeout, config.PollInterval)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *stackOutputsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state stackOutputsDataSourceModel
@@ -93,9 +176,47 @@ func (d *stackOutputsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	// Resolve the stack, which may be selected by name
+	stackID, diags := resolveStackID(d.client, state.StackID, state.StackName, state.Space)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for any active tracked run to finish if requested
+	waitOpts, diags := newWaitOptions(state.WaitForIdle, state.Timeout, state.PollInterval)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(waitForIdle(ctx, d.client, stackID, waitOpts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refuse to consume outputs of a drifted stack if requested
+	if state.FailOnDrift.ValueBool() {
+		drifted, err := d.client.CountDriftedResources(stackID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading SpaceLift Stack Resources",
+				"Could not check the stack for drift: "+err.Error(),
+			)
+			return
+		}
+		if drifted > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fail_on_drift"),
+				"SpaceLift Stack Has Drifted",
+				fmt.Sprintf("Stack '%s' has %d drifted resource(s). Its outputs may not reflect the real infrastructure until the drift is reconciled.", stackID, drifted),
+			)
+			return
+		}
+	}
+
 	// Get stack outputs from SpaceLift
-	stackID := state.StackID.ValueString()
-	outputs, err := d.client.GetStackOutputs(stackID)
+	opts := newOutputOptions(state.Source, state.RunID, state.CommitSHA)
+	outputs, runID, err := getOutputs(d.client, stackID, opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading SpaceLift Stack Outputs",
@@ -104,19 +225,55 @@ func (d *stackOutputsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	// Create a map of string values for the outputs
+	// Create a map of string values for the outputs. Outputs marked as
+	// sensitive in state are kept apart so they are never shown in plans.
 	outputMap := make(map[string]attr.Value)
+	sensitiveMap := make(map[string]attr.Value)
 	for _, output := range outputs {
+		if output.Sensitive {
+			sensitiveMap[output.ID] = types.StringValue(output.Value)
+			continue
+		}
 		outputMap[output.ID] = types.StringValue(output.Value)
 	}
-	
+
 	// Create a Map value from the map of string values
 	outputsValue, diags := types.MapValue(types.StringType, outputMap)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	
+
+	// Output types and sensitivity are only known when reading from state
+	state.OutputTypes = types.MapNull(types.StringType)
+	state.SensitiveOutputs = types.ListNull(types.StringType)
+	state.SensitiveValues = types.MapNull(types.StringType)
+	if opts.fromState() {
+		typeMap := make(map[string]attr.Value)
+		sensitive := []string{}
+		for _, output := range outputs {
+			typeMap[output.ID] = types.StringValue(output.Type)
+			if output.Sensitive {
+				sensitive = append(sensitive, output.ID)
+			}
+		}
+
+		state.OutputTypes, diags = types.MapValue(types.StringType, typeMap)
+		resp.Diagnostics.Append(diags...)
+		state.SensitiveOutputs, diags = types.ListValueFrom(ctx, types.StringType, sensitive)
+		resp.Diagnostics.Append(diags...)
+		state.SensitiveValues, diags = types.MapValue(types.StringType, sensitiveMap)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.RunID = types.StringNull()
+	if runID != "" {
+		state.RunID = types.StringValue(runID)
+	}
+	state.StackID = types.StringValue(stackID)
 	state.ID = types.StringValue(stackID)
 	state.Outputs = outputsValue
 	state.LastCheck = types.StringValue(time.Now().Format(time.RFC3339))
@@ -127,4 +284,4 @@ func (d *stackOutputsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-} 
\ No newline at end of file
+}
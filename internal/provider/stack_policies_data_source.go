@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &stackPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &stackPoliciesDataSource{}
+)
+
+// NewStackPoliciesDataSource is a helper function to simplify the provider implementation.
+func NewStackPoliciesDataSource() datasource.DataSource {
+	return &stackPoliciesDataSource{}
+}
+
+// stackPoliciesDataSource is the data source implementation.
+type stackPoliciesDataSource struct {
+	client *SpaceLiftClient
+}
+
+// stackPoliciesDataSourceModel maps the data source schema data.
+type stackPoliciesDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	StackID   types.String `tfsdk:"stack_id"`
+	Policies  types.List   `tfsdk:"policies"`
+	PolicyIDs types.List   `tfsdk:"policy_ids"`
+}
+
+// attachedPolicyModel maps a policy attached to a stack.
+type attachedPolicyModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// attachedPolicyAttrTypes are the attribute types of attachedPolicyModel.
+var attachedPolicyAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+	"type": types.StringType,
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *stackPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *stackPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_policies"
+}
+
+// Schema defines the schema for the data source.
+func (d *stackPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the policies attached to a SpaceLift stack.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack.",
+				Required:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "The policies attached to the stack, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the policy.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the policy, such as PLAN or APPROVAL.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"policy_ids": schema.ListAttribute{
+				Description: "The IDs of the policies attached to the stack.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *stackPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state stackPoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	policies, err := d.client.GetStackPolicies(stackID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Policies",
+			"Could not read stack policies: "+err.Error(),
+		)
+		return
+	}
+
+	models := []attachedPolicyModel{}
+	policyIDs := []string{}
+	for _, policy := range policies {
+		models = append(models, attachedPolicyModel{
+			ID:   types.StringValue(policy.PolicyID),
+			Name: types.StringValue(policy.PolicyName),
+			Type: types.StringValue(policy.PolicyType),
+		})
+		policyIDs = append(policyIDs, policy.PolicyID)
+	}
+
+	policiesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: attachedPolicyAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	policyIDsValue, diags := types.ListValueFrom(ctx, types.StringType, policyIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(stackID)
+	state.Policies = policiesValue
+	state.PolicyIDs = policyIDsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &stackResourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &stackResourcesDataSource{}
+)
+
+// NewStackResourcesDataSource is a helper function to simplify the provider implementation.
+func NewStackResourcesDataSource() datasource.DataSource {
+	return &stackResourcesDataSource{}
+}
+
+// stackResourcesDataSource is the data source implementation.
+type stackResourcesDataSource struct {
+	client *SpaceLiftClient
+}
+
+// stackResourcesDataSourceModel maps the data source schema data.
+type stackResourcesDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	StackID       types.String `tfsdk:"stack_id"`
+	Types         types.List   `tfsdk:"types"`
+	AddressPrefix types.String `tfsdk:"address_prefix"`
+	Resources     types.List   `tfsdk:"resources"`
+}
+
+// stackResourceModel maps a resource in the resources list.
+type stackResourceModel struct {
+	Address  types.String `tfsdk:"address"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Provider types.String `tfsdk:"provider"`
+	Drifted  types.Bool   `tfsdk:"drifted"`
+}
+
+// stackResourceAttrTypes are the attribute types of stackResourceModel.
+var stackResourceAttrTypes = map[string]attr.Type{
+	"address":  types.StringType,
+	"name":     types.StringType,
+	"type":     types.StringType,
+	"provider": types.StringType,
+	"drifted":  types.BoolType,
+}
+
+// newStackResourceModel converts a stack resource into its Terraform model.
+func newStackResourceModel(resource StackResource) stackResourceModel {
+	return stackResourceModel{
+		Address:  types.StringValue(resource.Address),
+		Name:     types.StringValue(resource.Name),
+		Type:     types.StringValue(resource.Type),
+		Provider: types.StringValue(resource.Provider),
+		Drifted:  types.BoolValue(resource.Drifted),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *stackResourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *stackResourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_resources"
+}
+
+// Schema defines the schema for the data source.
+func (d *stackResourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the Terraform resources managed by a SpaceLift stack, as tracked by SpaceLift.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack.",
+				Required:    true,
+			},
+			"types": schema.ListAttribute{
+				Description: "Only return resources of one of these types, such as aws_vpc.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"address_prefix": schema.StringAttribute{
+				Description: "Only return resources whose address starts with this prefix, such as \"module.vpc.\".",
+				Optional:    true,
+			},
+			"resources": schema.ListNestedAttribute{
+				Description: "The matching resources, sorted by address.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "The address of the resource, such as module.vpc.aws_vpc.this.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the resource.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the resource, such as aws_vpc.",
+							Computed:    true,
+						},
+						"provider": schema.StringAttribute{
+							Description: "The provider configuration managing the resource.",
+							Computed:    true,
+						},
+						"drifted": schema.BoolAttribute{
+							Description: "Whether drift detection found the resource to have drifted.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *stackResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state stackResourcesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := ResourceFilter{
+		AddressPrefix: state.AddressPrefix.ValueString(),
+	}
+	resp.Diagnostics.Append(state.Types.ElementsAs(ctx, &filter.Types, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := state.StackID.ValueString()
+	resources, err := d.client.GetStackResources(stackID, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Stack Resources",
+			"Could not read stack resources: "+err.Error(),
+		)
+		return
+	}
+
+	models := []stackResourceModel{}
+	for _, resource := range resources {
+		models = append(models, newStackResourceModel(resource))
+	}
+
+	resourcesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: stackResourceAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(stackID)
+	state.Resources = resourcesValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestStackResourcesDataSourceMetadata tests the data source metadata.
+func TestStackResourcesDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackResourcesDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_stack_resources", resp.TypeName)
+}
+
+// TestStackResourcesDataSourceSchema tests the data source schema.
+func TestStackResourcesDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &stackResourcesDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"stack_id", "types", "address_prefix", "resources"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
+
+// TestNewStackResourceModel tests the conversion of resources into their Terraform model.
+func TestNewStackResourceModel(t *testing.T) {
+	model := newStackResourceModel(StackResource{
+		Address: "aws_subnet.private[0]",
+		Name:    "private",
+		Type:    "aws_subnet",
+		Drifted: true,
+	})
+
+	assert.Equal(t, types.StringValue("aws_subnet.private[0]"), model.Address)
+	assert.Equal(t, types.StringValue("aws_subnet"), model.Type)
+	assert.Equal(t, types.BoolValue(true), model.Drifted)
+}
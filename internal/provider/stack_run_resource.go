@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultRunTimeout is how long to wait for a triggered run when timeout is not set.
+	defaultRunTimeout = 30 * time.Minute
+	// defaultRunPollInterval is how often to poll a triggered run when poll_interval is not set.
+	defaultRunPollInterval = 15 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &stackRunResource{}
+	_ resource.ResourceWithConfigure      = &stackRunResource{}
+	_ resource.ResourceWithValidateConfig = &stackRunResource{}
+)
+
+// NewStackRunResource is a helper function to simplify the provider implementation.
+func NewStackRunResource() resource.Resource {
+	return &stackRunResource{}
+}
+
+// stackRunResource is the resource implementation.
+type stackRunResource struct {
+	client *SpaceLiftClient
+}
+
+// stackRunResourceModel maps the resource schema data.
+type stackRunResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	StackID      types.String `tfsdk:"stack_id"`
+	CommitSHA    types.String `tfsdk:"commit_sha"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	AutoConfirm  types.Bool   `tfsdk:"auto_confirm"`
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+	RunID        types.String `tfsdk:"run_id"`
+	State        types.String `tfsdk:"state"`
+	Outputs      types.Map    `tfsdk:"outputs"`
+	FinishedAt   types.String `tfsdk:"finished_at"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *stackRunResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *stackRunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_run"
+}
+
+// Schema defines the schema for the resource.
+func (r *stackRunResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Triggers a tracked run of a SpaceLift stack, waits for it to finish and exposes the resulting outputs. A new run is triggered whenever stack_id, commit_sha or triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": computedString("Identifier of the resource. This is the same as run_id."),
+			"stack_id": schema.StringAttribute{
+				Description: "The ID of the SpaceLift stack to run.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_sha": schema.StringAttribute{
+				Description: "The commit to run. If not set, the head of the stack's tracked branch is run.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that trigger a new run whenever they change.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_confirm": schema.BoolAttribute{
+				Description: "Confirm the run when its plan waits for confirmation. Otherwise the run must be confirmed in SpaceLift before timeout. Defaults to false.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the run to finish, as a Go duration such as \"1h\". Defaults to \"30m\".",
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often to check whether the run has finished, as a Go duration such as \"30s\". Defaults to \"15s\".",
+				Optional:    true,
+			},
+			"run_id":      computedString("The ID of the triggered run."),
+			"state":       computedString("The final state of the run."),
+			"finished_at": computedString("The timestamp the run finished."),
+			"outputs": schema.MapAttribute{
+				Description: "The outputs of the stack after the run finished.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that timeout and poll_interval are valid durations.
+func (r *stackRunResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config stackRunResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := parseWaitDuration("timeout", config.Timeout, defaultRunTimeout)
+	resp.Diagnostics.Append(diags...)
+	_, diags = parseWaitDuration("poll_interval", config.PollInterval, defaultRunPollInterval)
+	resp.Diagnostics.Append(diags...)
+
+	if !config.CommitSHA.IsNull() && !config.CommitSHA.IsUnknown() && config.CommitSHA.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("commit_sha"),
+			"Invalid Commit SHA",
+			"The commit_sha attribute must not be empty.",
+		)
+	}
+}
+
+// Create triggers a run, waits for it to finish and captures the stack outputs.
+func (r *stackRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan stackRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := parseWaitDuration("timeout", plan.Timeout, defaultRunTimeout)
+	resp.Diagnostics.Append(diags...)
+	pollInterval, diags := parseWaitDuration("poll_interval", plan.PollInterval, defaultRunPollInterval)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := plan.StackID.ValueString()
+	triggered, err := r.client.TriggerRun(stackID, plan.CommitSHA.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Triggering SpaceLift Run",
+			"Could not trigger a run of stack '"+stackID+"': "+err.Error(),
+		)
+		return
+	}
+
+	// From here on the run exists, so it is recorded in state even if it does
+	// not finish. Terraform then taints the resource and replaces it with a new
+	// run, instead of losing track of this one and triggering a duplicate.
+	run, err := r.client.WaitForRun(ctx, stackID, triggered.ID, plan.AutoConfirm.ValueBool(), timeout, pollInterval)
+	if err != nil && run == nil {
+		// The run could not be read at all, so its state is the one it was
+		// triggered in
+		resp.Diagnostics.Append(recordUnfinishedRun(ctx, &resp.State, plan, triggered)...)
+		resp.Diagnostics.AddError(
+			"SpaceLift Run Did Not Finish",
+			fmt.Sprintf("Run %s of stack '%s' was triggered and may still be in progress in SpaceLift, but it could not be read: %s", triggered.ID, stackID, err.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(recordUnfinishedRun(ctx, &resp.State, plan, run)...)
+		resp.Diagnostics.AddError(
+			"SpaceLift Run Did Not Finish",
+			fmt.Sprintf("Run %s of stack '%s' did not finish successfully: %s", triggered.ID, stackID, err.Error()),
+		)
+		return
+	}
+
+	diags = r.setRun(&plan, stackID, run)
+	if diags.HasError() {
+		resp.Diagnostics.Append(recordUnfinishedRun(ctx, &resp.State, plan, run)...)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// recordUnfinishedRun saves the ID and last known state of a run that did not
+// finish successfully, leaving the other computed attributes null.
+func recordUnfinishedRun(ctx context.Context, state *tfsdk.State, plan stackRunResourceModel, run *Run) diag.Diagnostics {
+	plan.ID = types.StringValue(run.ID)
+	plan.RunID = types.StringValue(run.ID)
+	plan.State = types.StringValue(run.State)
+	plan.FinishedAt = types.StringNull()
+	plan.Outputs = types.MapNull(types.StringType)
+	return state.Set(ctx, &plan)
+}
+
+// setRun records a finished run and the resulting stack outputs in model.
+func (r *stackRunResource) setRun(model *stackRunResourceModel, stackID string, run *Run) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	outputs, err := r.client.GetStackOutputs(stackID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading SpaceLift Stack Outputs",
+			"Run "+run.ID+" finished, but the stack outputs could not be read: "+err.Error(),
+		)
+		return diags
+	}
+
+	outputsValue, d := outputsMapValue(outputs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ID = types.StringValue(run.ID)
+	model.RunID = types.StringValue(run.ID)
+	model.State = types.StringValue(run.State)
+	model.FinishedAt = types.StringValue(run.FinishedTime().Format(time.RFC3339))
+	model.Outputs = outputsValue
+	return diags
+}
+
+// Read keeps the recorded run. The outputs are those of the stack when the run
+// finished, so they are not refreshed.
+func (r *stackRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state stackRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update records changes to auto_confirm, timeout and poll_interval, which only
+// apply to future runs. Every other change triggers a new run through replacement.
+func (r *stackRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan stackRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the run from state. The run and its changes are kept in SpaceLift.
+func (r *stackRunResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
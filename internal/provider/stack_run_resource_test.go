@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStackRunResourceMetadata tests the resource metadata.
+func TestStackRunResourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	r := &stackRunResource{}
+
+	req := resource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &resource.MetadataResponse{}
+	r.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_stack_run", resp.TypeName)
+}
+
+// TestStackRunResourceSchema tests the resource schema.
+func TestStackRunResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	r := &stackRunResource{}
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"id", "stack_id", "commit_sha", "triggers", "auto_confirm", "timeout", "poll_interval", "run_id", "state", "outputs", "finished_at"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+	assert.True(t, resp.Schema.Attributes["stack_id"].IsRequired())
+	for _, name := range []string{"run_id", "state", "outputs", "finished_at"} {
+		assert.True(t, resp.Schema.Attributes[name].IsComputed(), name)
+		assert.False(t, resp.Schema.Attributes[name].IsOptional(), name)
+	}
+}
+
+// TestStackRunResourceCreateRecordsFailedRun tests that a run that does not
+// finish is recorded in state, so that the next apply does not trigger a duplicate.
+// testStackRunCreate creates a stack_run resource for stack "network" against
+// a SpaceLift API served by handler.
+func testStackRunCreate(t *testing.T, handler http.HandlerFunc) *resource.CreateResponse {
+	ctx := context.Background()
+	res := &stackRunResource{client: newTestClient(t, handler)}
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for _, name := range []string{"id", "run_id", "state", "finished_at"} {
+		values[name] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	values["outputs"] = tftypes.NewValue(objectType.AttributeTypes["outputs"], tftypes.UnknownValue)
+	values["stack_id"] = tftypes.NewValue(tftypes.String, "network")
+	values["poll_interval"] = tftypes.NewValue(tftypes.String, "1ms")
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	res.Create(ctx, req, resp)
+	return resp
+}
+
+// testTriggeredRunHandler answers the runTrigger mutation with run-1 and every
+// other query with stack.
+func testTriggeredRunHandler(t *testing.T, stack interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := decodeGraphQLRequest(t, r)
+		if strings.Contains(request.Query, "runTrigger") {
+			writeGraphQLData(t, w, map[string]interface{}{
+				"runTrigger": map[string]interface{}{"id": "run-1", "type": "TRACKED", "state": "QUEUED"},
+			})
+			return
+		}
+		writeGraphQLData(t, w, map[string]interface{}{"stack": stack})
+	}
+}
+
+// assertRecordedRun asserts the run ID and state recorded in the state of resp.
+func assertRecordedRun(t *testing.T, resp *resource.CreateResponse, wantState string) {
+	ctx := context.Background()
+	var runID, state types.String
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("run_id"), &runID).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("state"), &state).HasError())
+	assert.Equal(t, "run-1", runID.ValueString())
+	assert.Equal(t, wantState, state.ValueString())
+}
+
+func TestStackRunResourceCreateRecordsFailedRun(t *testing.T) {
+	resp := testStackRunCreate(t, testTriggeredRunHandler(t, map[string]interface{}{
+		"run": map[string]interface{}{"id": "run-1", "type": "TRACKED", "state": "FAILED", "finished": true},
+	}))
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "Run run-1 of stack 'network' did not finish successfully")
+	assertRecordedRun(t, resp, "FAILED")
+}
+
+func TestStackRunResourceCreateRecordsUnreadableRun(t *testing.T) {
+	resp := testStackRunCreate(t, testTriggeredRunHandler(t, nil))
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "Run run-1 of stack 'network' was triggered and may still be in progress in SpaceLift")
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "stack network not found")
+	assertRecordedRun(t, resp, "QUEUED")
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateStackSelector checks that a stack is selected either by stack_id, or
+// by stack_name with an optional space.
+func validateStackSelector(stackID, stackName, space types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if stackID.IsUnknown() || stackName.IsUnknown() {
+		return diags
+	}
+
+	if stackID.IsNull() == stackName.IsNull() {
+		diags.AddAttributeError(
+			path.Root("stack_id"),
+			"Invalid Stack Selector",
+			"Exactly one of stack_id and stack_name must be set.",
+		)
+	}
+
+	if !space.IsNull() && stackName.IsNull() {
+		diags.AddAttributeError(
+			path.Root("space"),
+			"Invalid Stack Selector",
+			"The space attribute can only be set together with stack_name.",
+		)
+	}
+
+	return diags
+}
+
+// resolveStackID returns the ID of the selected stack, looking up stacks
+// selected by name through the SpaceLift API.
+func resolveStackID(client *SpaceLiftClient, stackID, stackName, space types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !stackID.IsNull() {
+		return stackID.ValueString(), diags
+	}
+
+	stack, err := client.FindStackByName(stackName.ValueString(), space.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("stack_name"),
+			"Unable to Resolve SpaceLift Stack",
+			"Could not find the stack by name: "+err.Error(),
+		)
+		return "", diags
+	}
+
+	return stack.ID, diags
+}
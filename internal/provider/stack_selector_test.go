@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStackSelector(t *testing.T) {
+	testCases := map[string]struct {
+		stackID   types.String
+		stackName types.String
+		space     types.String
+		wantError bool
+	}{
+		"stack_id":              {stackID: types.StringValue("vpc-prod"), stackName: types.StringNull(), space: types.StringNull()},
+		"stack_name":            {stackID: types.StringNull(), stackName: types.StringValue("vpc"), space: types.StringNull()},
+		"stack_name with space": {stackID: types.StringNull(), stackName: types.StringValue("vpc"), space: types.StringValue("root/prod")},
+		"unknown stack_name":    {stackID: types.StringNull(), stackName: types.StringUnknown(), space: types.StringNull()},
+		"neither":               {stackID: types.StringNull(), stackName: types.StringNull(), space: types.StringNull(), wantError: true},
+		"both":                  {stackID: types.StringValue("vpc-prod"), stackName: types.StringValue("vpc"), space: types.StringNull(), wantError: true},
+		"space with stack_id":   {stackID: types.StringValue("vpc-prod"), stackName: types.StringNull(), space: types.StringValue("root"), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateStackSelector(tc.stackID, tc.stackName, tc.space)
+			assert.Equal(t, tc.wantError, diags.HasError())
+		})
+	}
+}
+
+func TestResolveStackID(t *testing.T) {
+	client := newTestClient(t, stackSearchHandler(t,
+		map[string]interface{}{"id": "vpc-prod", "name": "vpc", "space": "network-01"},
+		map[string]interface{}{"id": "vpc-dev", "name": "vpc", "space": "network-02"},
+	))
+
+	stackID, diags := resolveStackID(client, types.StringValue("explicit"), types.StringNull(), types.StringNull())
+	require.False(t, diags.HasError())
+	assert.Equal(t, "explicit", stackID)
+
+	stackID, diags = resolveStackID(client, types.StringNull(), types.StringValue("vpc"), types.StringValue("network-02"))
+	require.False(t, diags.HasError())
+	assert.Equal(t, "vpc-dev", stackID)
+
+	_, diags = resolveStackID(client, types.StringNull(), types.StringValue("vpc"), types.StringNull())
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), `multiple stacks named "vpc" found`)
+}
@@ -0,0 +1,21 @@
+package provider
+
+// containsString reports whether values contains value.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAllStrings reports whether values contains every value in required.
+func containsAllStrings(values, required []string) bool {
+	for _, value := range required {
+		if !containsString(values, value) {
+			return false
+		}
+	}
+	return true
+}
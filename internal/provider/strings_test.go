@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsAllStrings(t *testing.T) {
+	labels := []string{"team:network", "env:prod"}
+
+	assert.True(t, containsAllStrings(labels, nil))
+	assert.True(t, containsAllStrings(labels, []string{"env:prod"}))
+	assert.True(t, containsAllStrings(labels, []string{"env:prod", "team:network"}))
+	assert.False(t, containsAllStrings(labels, []string{"env:prod", "team:compute"}))
+	assert.False(t, containsAllStrings(nil, []string{"env:prod"}))
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// defaultTokenExpiryWindow is how long the API token is expected to remain
+// valid for when token_expiry_window is not configured.
+const defaultTokenExpiryWindow = time.Hour
+
+// tokenClaims holds the claims of a SpaceLift API token that the provider cares about.
+type tokenClaims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Expiry returns the expiry time of the token, or the zero time if the token has no exp claim.
+func (c tokenClaims) Expiry() time.Time {
+	if c.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.ExpiresAt, 0).UTC()
+}
+
+// parseTokenClaims decodes the claims of a JWT without verifying its signature.
+func parseTokenClaims(token string) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding token claims: %w", err)
+	}
+
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("error unmarshalling token claims: %w", err)
+	}
+
+	return &claims, nil
+}
+
+// tokenExpiryDiagnostics returns an error if the token has already expired at
+// now, or a warning if it expires within window.
+func tokenExpiryDiagnostics(claims *tokenClaims, now time.Time, window time.Duration, tokenAttribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	expiry := claims.Expiry()
+	if expiry.IsZero() {
+		return diags
+	}
+
+	subject := claims.Subject
+	if subject == "" {
+		subject = "unknown subject"
+	}
+
+	if !expiry.After(now) {
+		diags.AddAttributeError(
+			tokenAttribute,
+			"Expired SpaceLift API Token",
+			fmt.Sprintf("The SpaceLift API token for %s expired at %s. Generate a new token and try again.",
+				subject, expiry.Format(time.RFC3339)),
+		)
+		return diags
+	}
+
+	if expiry.Before(now.Add(window)) {
+		diags.AddAttributeWarning(
+			tokenAttribute,
+			"SpaceLift API Token Expires Soon",
+			fmt.Sprintf("The SpaceLift API token for %s expires at %s, in %s. "+
+				"Long-running plans or applies may fail once it expires.",
+				subject, expiry.Format(time.RFC3339), expiry.Sub(now).Round(time.Second)),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testToken builds an unsigned JWT with the given claims payload.
+func testToken(payload string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestParseTokenClaims(t *testing.T) {
+	claims, err := parseTokenClaims(testToken(`{"sub":"api::ci-key","exp":1767225600}`))
+	require.NoError(t, err)
+	assert.Equal(t, "api::ci-key", claims.Subject)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), claims.Expiry())
+}
+
+func TestParseTokenClaimsWithoutExpiry(t *testing.T) {
+	claims, err := parseTokenClaims(testToken(`{"sub":"api::ci-key"}`))
+	require.NoError(t, err)
+	assert.True(t, claims.Expiry().IsZero())
+}
+
+func TestParseTokenClaimsNotJWT(t *testing.T) {
+	_, err := parseTokenClaims("opaque-token")
+	assert.EqualError(t, err, "token is not a JWT")
+}
+
+func TestTokenExpiryDiagnostics(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tokenAttribute := path.Root("api_token")
+
+	testCases := map[string]struct {
+		expiry       time.Time
+		wantError    bool
+		wantWarnings int
+	}{
+		"valid":         {expiry: now.Add(3 * time.Hour)},
+		"expires soon":  {expiry: now.Add(30 * time.Minute), wantWarnings: 1},
+		"expired":       {expiry: now.Add(-time.Minute), wantError: true},
+		"no expiration": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			claims := &tokenClaims{Subject: "api::ci-key"}
+			if !tc.expiry.IsZero() {
+				claims.ExpiresAt = tc.expiry.Unix()
+			}
+
+			diags := tokenExpiryDiagnostics(claims, now, time.Hour, tokenAttribute)
+			assert.Equal(t, tc.wantError, diags.HasError())
+			assert.Len(t, diags.Warnings(), tc.wantWarnings)
+			for _, d := range diags {
+				assert.Contains(t, d.Detail(), "api::ci-key")
+			}
+		})
+	}
+}
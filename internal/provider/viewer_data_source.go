@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &viewerDataSource{}
+	_ datasource.DataSourceWithConfigure = &viewerDataSource{}
+)
+
+// NewViewerDataSource is a helper function to simplify the provider implementation.
+func NewViewerDataSource() datasource.DataSource {
+	return &viewerDataSource{}
+}
+
+// viewerDataSource is the data source implementation.
+type viewerDataSource struct {
+	client *SpaceLiftClient
+}
+
+// viewerDataSourceModel maps the data source schema data.
+type viewerDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	LoginMethod types.String `tfsdk:"login_method"`
+	Admin       types.Bool   `tfsdk:"admin"`
+	Teams       types.List   `tfsdk:"teams"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *viewerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *viewerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_viewer"
+}
+
+// Schema defines the schema for the data source.
+func (d *viewerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the SpaceLift identity the provider is authenticated as.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the authenticated identity.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the authenticated identity, such as the API key name or user name.",
+				Computed:    true,
+			},
+			"login_method": schema.StringAttribute{
+				Description: "How the identity logged in, such as API_KEY for API keys.",
+				Computed:    true,
+			},
+			"admin": schema.BoolAttribute{
+				Description: "Whether the identity is an account administrator.",
+				Computed:    true,
+			},
+			"teams": schema.ListAttribute{
+				Description: "The teams, or identity provider groups, the identity is a member of.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *viewerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state viewerDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewer, err := d.client.GetViewer()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Viewer",
+			"Could not read the authenticated identity: "+err.Error(),
+		)
+		return
+	}
+
+	teams, diags := types.ListValueFrom(ctx, types.StringType, viewer.Teams)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(viewer.ID)
+	state.Name = types.StringValue(viewer.Name)
+	state.LoginMethod = types.StringValue(viewer.LoginMethod)
+	state.Admin = types.BoolValue(viewer.Admin)
+	state.Teams = teams
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
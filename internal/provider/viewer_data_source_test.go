@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestViewerDataSourceMetadata tests the data source metadata.
+func TestViewerDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+	ds := &viewerDataSource{}
+
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "spaceliftoutput",
+	}
+	resp := &datasource.MetadataResponse{}
+	ds.Metadata(ctx, req, resp)
+
+	assert.Equal(t, "spaceliftoutput_viewer", resp.TypeName)
+}
+
+// TestViewerDataSourceSchema tests the data source schema.
+func TestViewerDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	ds := &viewerDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"id", "name", "login_method", "admin", "teams"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+}
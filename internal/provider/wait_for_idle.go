@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultWaitTimeout is how long to wait for a stack to be idle when timeout is not set.
+	defaultWaitTimeout = 10 * time.Minute
+	// defaultWaitPollInterval is how often to poll a busy stack when poll_interval is not set.
+	defaultWaitPollInterval = 15 * time.Second
+)
+
+// waitOptions controls whether, and for how long, to wait for a stack to be
+// idle before reading its outputs.
+type waitOptions struct {
+	Enabled      bool
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+// parseWaitDuration parses a positive duration attribute, returning def if the
+// attribute is not set.
+func parseWaitDuration(name string, value types.String, def time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		return def, diags
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration <= 0 {
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid Duration",
+			fmt.Sprintf("The %s value must be a positive Go duration such as \"30s\" or \"15m\", got: %s", name, value.ValueString()),
+		)
+	}
+
+	return duration, diags
+}
+
+// newWaitOptions builds waitOptions from the wait_for_idle, timeout and poll_interval attributes.
+func newWaitOptions(waitForIdle types.Bool, timeout, pollInterval types.String) (waitOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := waitOptions{Enabled: waitForIdle.ValueBool()}
+
+	var d diag.Diagnostics
+	opts.Timeout, d = parseWaitDuration("timeout", timeout, defaultWaitTimeout)
+	diags.Append(d...)
+	opts.PollInterval, d = parseWaitDuration("poll_interval", pollInterval, defaultWaitPollInterval)
+	diags.Append(d...)
+
+	return opts, diags
+}
+
+// validateWaitOptions checks that timeout and poll_interval are valid durations
+// and are only set when wait_for_idle is enabled.
+func validateWaitOptions(waitForIdle types.Bool, timeout, pollInterval types.String) diag.Diagnostics {
+	_, diags := newWaitOptions(waitForIdle, timeout, pollInterval)
+
+	if waitForIdle.IsUnknown() || waitForIdle.ValueBool() {
+		return diags
+	}
+
+	for _, attribute := range []struct {
+		name  string
+		value types.String
+	}{{"timeout", timeout}, {"poll_interval", pollInterval}} {
+		if !attribute.value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid Wait Configuration",
+				fmt.Sprintf("The %s attribute can only be set when wait_for_idle is true.", attribute.name),
+			)
+		}
+	}
+
+	return diags
+}
+
+// waitForIdle waits for the stack to be idle if opts enables it, reporting a
+// timeout on the wait_for_idle attribute.
+func waitForIdle(ctx context.Context, client *SpaceLiftClient, stackID string, opts waitOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !opts.Enabled {
+		return diags
+	}
+
+	_, err := client.WaitForStackIdle(ctx, stackID, opts.Timeout, opts.PollInterval)
+	if errors.Is(err, errPollTimeout) {
+		diags.AddAttributeError(
+			path.Root("wait_for_idle"),
+			"SpaceLift Stack Still Running",
+			"The stack still has an active tracked run, so its outputs were not read: "+err.Error(),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Reading SpaceLift Stack",
+			"Could not wait for the stack to be idle: "+err.Error(),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWaitOptions(t *testing.T) {
+	testCases := map[string]struct {
+		waitForIdle  types.Bool
+		timeout      types.String
+		pollInterval types.String
+		wantError    bool
+	}{
+		"disabled":                   {waitForIdle: types.BoolNull(), timeout: types.StringNull(), pollInterval: types.StringNull()},
+		"defaults":                   {waitForIdle: types.BoolValue(true), timeout: types.StringNull(), pollInterval: types.StringNull()},
+		"custom":                     {waitForIdle: types.BoolValue(true), timeout: types.StringValue("30m"), pollInterval: types.StringValue("1m")},
+		"unknown wait_for_idle":      {waitForIdle: types.BoolUnknown(), timeout: types.StringValue("30m"), pollInterval: types.StringNull()},
+		"unknown timeout":            {waitForIdle: types.BoolValue(true), timeout: types.StringUnknown(), pollInterval: types.StringNull()},
+		"invalid timeout":            {waitForIdle: types.BoolValue(true), timeout: types.StringValue("soon"), pollInterval: types.StringNull(), wantError: true},
+		"zero poll_interval":         {waitForIdle: types.BoolValue(true), timeout: types.StringNull(), pollInterval: types.StringValue("0s"), wantError: true},
+		"timeout without wait":       {waitForIdle: types.BoolValue(false), timeout: types.StringValue("30m"), pollInterval: types.StringNull(), wantError: true},
+		"poll_interval without wait": {waitForIdle: types.BoolNull(), timeout: types.StringNull(), pollInterval: types.StringValue("1m"), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateWaitOptions(tc.waitForIdle, tc.timeout, tc.pollInterval)
+			assert.Equal(t, tc.wantError, diags.HasError())
+		})
+	}
+}
+
+func TestNewWaitOptions(t *testing.T) {
+	opts, diags := newWaitOptions(types.BoolValue(true), types.StringNull(), types.StringValue("1m"))
+	require.False(t, diags.HasError())
+	assert.Equal(t, waitOptions{Enabled: true, Timeout: defaultWaitTimeout, PollInterval: time.Minute}, opts)
+
+	opts, diags = newWaitOptions(types.BoolNull(), types.StringNull(), types.StringNull())
+	require.False(t, diags.HasError())
+	assert.False(t, opts.Enabled)
+}
+
+func TestWaitForIdleTimeout(t *testing.T) {
+	var polls int
+	client := newTestClient(t, stackStatesHandler(t, &polls, "APPLYING"))
+
+	opts := waitOptions{Enabled: true, Timeout: 10 * time.Millisecond, PollInterval: 5 * time.Millisecond}
+	diags := waitForIdle(context.Background(), client, "network", opts)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "SpaceLift Stack Still Running", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "still in state APPLYING")
+	assert.Equal(t, path.Root("wait_for_idle"), diags[0].(interface{ Path() path.Path }).Path())
+}
+
+func TestWaitForIdleCanceled(t *testing.T) {
+	var polls int
+	client := newTestClient(t, stackStatesHandler(t, &polls, "APPLYING"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	opts := waitOptions{Enabled: true, Timeout: time.Hour, PollInterval: time.Hour}
+	diags := waitForIdle(ctx, client, "network", opts)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Error Reading SpaceLift Stack", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "context canceled")
+}
+
+func TestWaitForIdleDisabled(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the stack must not be polled when waiting is disabled")
+	})
+
+	diags := waitForIdle(context.Background(), client, "network", waitOptions{})
+	assert.False(t, diags.HasError())
+}
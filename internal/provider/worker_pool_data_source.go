@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &workerPoolDataSource{}
+	_ datasource.DataSourceWithConfigure      = &workerPoolDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &workerPoolDataSource{}
+)
+
+// NewWorkerPoolDataSource is a helper function to simplify the provider implementation.
+func NewWorkerPoolDataSource() datasource.DataSource {
+	return &workerPoolDataSource{}
+}
+
+// workerPoolDataSource is the data source implementation.
+type workerPoolDataSource struct {
+	client *SpaceLiftClient
+}
+
+// workerPoolDataSourceModel maps the data source schema data.
+type workerPoolDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	WorkerPoolID types.String `tfsdk:"worker_pool_id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Space        types.String `tfsdk:"space"`
+	Labels       types.List   `tfsdk:"labels"`
+	Status       types.String `tfsdk:"status"`
+	BusyWorkers  types.Int64  `tfsdk:"busy_workers"`
+	IdleWorkers  types.Int64  `tfsdk:"idle_workers"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workerPoolDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *workerPoolDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worker_pool"
+}
+
+// Schema defines the schema for the data source.
+func (d *workerPoolDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a SpaceLift private worker pool by ID or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"worker_pool_id": schema.StringAttribute{
+				Description: "The ID of the worker pool. Exactly one of worker_pool_id and name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the worker pool. The name must be unique among the worker pools the API token can see. Exactly one of worker_pool_id and name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the worker pool.",
+				Computed:    true,
+			},
+			"space": schema.StringAttribute{
+				Description: "The ID of the space the worker pool belongs to.",
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "The labels of the worker pool.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.StringAttribute{
+				Description: workerPoolStatusDescription,
+				Computed:    true,
+			},
+			"busy_workers": schema.Int64Attribute{
+				Description: "The number of workers processing a run.",
+				Computed:    true,
+			},
+			"idle_workers": schema.Int64Attribute{
+				Description: "The number of workers ready to accept a run. Drained workers are not counted.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that exactly one of worker_pool_id and name is set.
+func (d *workerPoolDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config workerPoolDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.WorkerPoolID.IsUnknown() || config.Name.IsUnknown() {
+		return
+	}
+
+	if config.WorkerPoolID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("worker_pool_id"),
+			"Invalid Worker Pool Selector",
+			"Exactly one of worker_pool_id and name must be set.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workerPoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workerPoolDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pools, err := d.client.GetWorkerPools()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Worker Pools",
+			"Could not read worker pools: "+err.Error(),
+		)
+		return
+	}
+
+	var pool *WorkerPool
+	if !state.WorkerPoolID.IsNull() {
+		pool, err = findWorkerPool(pools, state.WorkerPoolID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("worker_pool_id"),
+				"Worker Pool Not Found",
+				"Could not find worker pool: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		pool, err = findWorkerPoolByName(pools, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Worker Pool Not Found",
+				"Could not find worker pool: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	model, diags := newWorkerPoolModel(ctx, *pool)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = model.ID
+	state.WorkerPoolID = model.ID
+	state.Name = model.Name
+	state.Description = model.Description
+	state.Space = model.Space
+	state.Labels = model.Labels
+	state.Status = model.Status
+	state.BusyWorkers = model.BusyWorkers
+	state.IdleWorkers = model.IdleWorkers
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWorkerPoolDataSourceMetadata tests the data source metadata.
+func TestWorkerPoolDataSourceMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	for ds, want := range map[datasource.DataSource]string{
+		&workerPoolDataSource{}:  "spaceliftoutput_worker_pool",
+		&workerPoolsDataSource{}: "spaceliftoutput_worker_pools",
+	} {
+		resp := &datasource.MetadataResponse{}
+		ds.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "spaceliftoutput"}, resp)
+		assert.Equal(t, want, resp.TypeName)
+	}
+}
+
+// TestWorkerPoolDataSourceSchema tests the data source schemas.
+func TestWorkerPoolDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	resp := &datasource.SchemaResponse{}
+	(&workerPoolDataSource{}).Schema(ctx, datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	for _, name := range []string{"worker_pool_id", "name", "space", "labels", "status", "busy_workers", "idle_workers"} {
+		assert.NotNil(t, resp.Schema.Attributes[name], name)
+	}
+
+	resp = &datasource.SchemaResponse{}
+	(&workerPoolsDataSource{}).Schema(ctx, datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, resp.Schema.Attributes["worker_pools"])
+}
+
+// TestNewWorkerPoolModel tests the conversion of worker pools into their Terraform model.
+func TestNewWorkerPoolModel(t *testing.T) {
+	pool := testWorkerPools()[0]
+	pool.Labels = []string{"env:prod"}
+
+	model, diags := newWorkerPoolModel(context.Background(), pool)
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("01-prod"), model.ID)
+	assert.Equal(t, types.StringValue(workerPoolStatusAvailable), model.Status)
+	assert.Equal(t, types.Int64Value(1), model.BusyWorkers)
+	assert.Equal(t, types.Int64Value(1), model.IdleWorkers)
+	assert.True(t, model.Description.IsNull())
+	assert.Len(t, model.Labels.Elements(), 1)
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workerPoolsDataSource{}
+	_ datasource.DataSourceWithConfigure = &workerPoolsDataSource{}
+)
+
+// NewWorkerPoolsDataSource is a helper function to simplify the provider implementation.
+func NewWorkerPoolsDataSource() datasource.DataSource {
+	return &workerPoolsDataSource{}
+}
+
+// workerPoolsDataSource is the data source implementation.
+type workerPoolsDataSource struct {
+	client *SpaceLiftClient
+}
+
+// workerPoolsDataSourceModel maps the data source schema data.
+type workerPoolsDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	WorkerPools types.List   `tfsdk:"worker_pools"`
+}
+
+// workerPoolModel maps a worker pool in the worker pools list.
+type workerPoolModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Space       types.String `tfsdk:"space"`
+	Labels      types.List   `tfsdk:"labels"`
+	Status      types.String `tfsdk:"status"`
+	BusyWorkers types.Int64  `tfsdk:"busy_workers"`
+	IdleWorkers types.Int64  `tfsdk:"idle_workers"`
+}
+
+// workerPoolAttrTypes are the attribute types of workerPoolModel.
+var workerPoolAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"name":         types.StringType,
+	"description":  types.StringType,
+	"space":        types.StringType,
+	"labels":       types.ListType{ElemType: types.StringType},
+	"status":       types.StringType,
+	"busy_workers": types.Int64Type,
+	"idle_workers": types.Int64Type,
+}
+
+// newWorkerPoolModel converts a worker pool into its Terraform model.
+func newWorkerPoolModel(ctx context.Context, pool WorkerPool) (workerPoolModel, diag.Diagnostics) {
+	labels, diags := types.ListValueFrom(ctx, types.StringType, pool.Labels)
+
+	return workerPoolModel{
+		ID:          types.StringValue(pool.ID),
+		Name:        types.StringValue(pool.Name),
+		Description: types.StringPointerValue(pool.Description),
+		Space:       types.StringValue(pool.Space),
+		Labels:      labels,
+		Status:      types.StringValue(pool.Status()),
+		BusyWorkers: types.Int64Value(int64(pool.BusyWorkers())),
+		IdleWorkers: types.Int64Value(int64(pool.IdleWorkers())),
+	}, diags
+}
+
+// workerPoolStatusDescription describes the status attribute of a worker pool.
+var workerPoolStatusDescription = fmt.Sprintf(
+	"The availability of the pool: %s if a worker is idle, %s if all workers are busy, or %s if no worker accepts runs.",
+	workerPoolStatusAvailable, workerPoolStatusBusy, workerPoolStatusOffline,
+)
+
+// Configure adds the provider configured client to the data source.
+func (d *workerPoolsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SpaceLiftClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SpaceLiftClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *workerPoolsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worker_pools"
+}
+
+// Schema defines the schema for the data source.
+func (d *workerPoolsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves all SpaceLift private worker pools the API token can see.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"worker_pools": schema.ListNestedAttribute{
+				Description: "The worker pools, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the worker pool.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the worker pool.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the worker pool.",
+							Computed:    true,
+						},
+						"space": schema.StringAttribute{
+							Description: "The ID of the space the worker pool belongs to.",
+							Computed:    true,
+						},
+						"labels": schema.ListAttribute{
+							Description: "The labels of the worker pool.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"status": schema.StringAttribute{
+							Description: workerPoolStatusDescription,
+							Computed:    true,
+						},
+						"busy_workers": schema.Int64Attribute{
+							Description: "The number of workers processing a run.",
+							Computed:    true,
+						},
+						"idle_workers": schema.Int64Attribute{
+							Description: "The number of workers ready to accept a run. Drained workers are not counted.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workerPoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workerPoolsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pools, err := d.client.GetWorkerPools()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SpaceLift Worker Pools",
+			"Could not read worker pools: "+err.Error(),
+		)
+		return
+	}
+
+	models := []workerPoolModel{}
+	for _, pool := range pools {
+		model, diags := newWorkerPoolModel(ctx, pool)
+		resp.Diagnostics.Append(diags...)
+		models = append(models, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workerPoolAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("worker_pools")
+	state.WorkerPools = poolsValue
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}